@@ -1,6 +1,7 @@
 package uptimerobot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -19,14 +20,14 @@ type accountResp struct {
 	Account Account `json:"account"`
 }
 
-func (c *Client) GetAccount() (acc Account, err error) {
-	url := fmt.Sprintf("%s/getAccountDetails", baseURL)
+func (c *Client) GetAccount(ctx context.Context) (acc Account, err error) {
+	url := fmt.Sprintf("%s/getAccountDetails", c.baseURL)
 	body, err := c.getAuthBody()
 	if err != nil {
 		return
 	}
 
-	respBody, err := getRequestResp(url, body)
+	respBody, err := c.getRequestResp(ctx, url, body)
 	if err != nil {
 		return
 	}
@@ -37,8 +38,9 @@ func (c *Client) GetAccount() (acc Account, err error) {
 		return
 	}
 
-	if resp.Stat != okStatus {
-		return acc, fmt.Errorf("unexpected status %s when getting account details", resp.Stat)
+	err = checkStatus("getAccountDetails", resp.baseResponse)
+	if err != nil {
+		return
 	}
 
 	return resp.Account, nil
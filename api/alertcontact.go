@@ -1,9 +1,11 @@
 package uptimerobot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -23,6 +25,9 @@ type AlertContact struct {
 
 type alertContactsResponse struct {
 	baseResponse
+	Offset        int64          `json:"offset"`
+	Limit         int64          `json:"limit"`
+	Total         int64          `json:"total"`
 	AlertContacts []AlertContact `json:"alert_contacts,omitempty"`
 }
 
@@ -97,14 +102,18 @@ func (c *Client) deleteAlertContactPayload(contact AlertContact) io.Reader {
 	return strings.NewReader(v.Encode())
 }
 
-func (c *Client) getAlertContactPayload(contact AlertContact) io.Reader {
+func (c *Client) getAlertContactsPayload(ids []string, offset int64) io.Reader {
 	v := c.baseValues()
-	v.Set("alert_contacts", contact.ID)
+	if len(ids) > 0 {
+		v.Set("alert_contacts", strings.Join(ids, "-"))
+	}
+	v.Set("offset", strconv.FormatInt(offset, 10))
+	v.Set("limit", strconv.Itoa(pageLimit))
 	return strings.NewReader(v.Encode())
 }
 
-func listAlertContacts(methodURL string, payload io.Reader) (resp alertContactsResponse, err error) {
-	respBody, err := postForm(methodURL, payload)
+func (c *Client) listAlertContacts(ctx context.Context, methodURL string, payload io.Reader) (resp alertContactsResponse, err error) {
+	respBody, err := c.postForm(ctx, methodURL, payload)
 	if err != nil {
 		return
 	}
@@ -114,16 +123,32 @@ func listAlertContacts(methodURL string, payload io.Reader) (resp alertContactsR
 		return
 	}
 
-	if resp.Stat != okStatus {
-		return resp, fmt.Errorf("unexpected status `%s` when creating alert contact, error type: %s, message: %s",
-			resp.Stat, resp.Error.Type, resp.Error.Message)
-	}
+	err = checkStatus(methodName(methodURL), resp.baseResponse)
+	return
+}
+
+// listAllAlertContacts fetches all pages of alert contacts, optionally restricted to the given IDs.
+func (c *Client) listAllAlertContacts(ctx context.Context, ids []string) (contacts []AlertContact, err error) {
+	getURL := fmt.Sprintf("%s/getAlertContacts", c.baseURL)
+	var offset int64
+	for {
+		payload := c.getAlertContactsPayload(ids, offset)
+		var resp alertContactsResponse
+		resp, err = c.listAlertContacts(ctx, getURL, payload)
+		if err != nil {
+			return
+		}
 
-	return resp, nil
+		contacts = append(contacts, resp.AlertContacts...)
+		offset += int64(len(resp.AlertContacts))
+		if len(resp.AlertContacts) == 0 || offset >= resp.Total {
+			return
+		}
+	}
 }
 
-func processAlertContact(methodURL string, payload io.Reader) (resp alertContactResponse, err error) {
-	respBody, err := postForm(methodURL, payload)
+func (c *Client) processAlertContact(ctx context.Context, methodURL string, payload io.Reader) (resp alertContactResponse, err error) {
+	respBody, err := c.postForm(ctx, methodURL, payload)
 	if err != nil {
 		return
 	}
@@ -133,44 +158,56 @@ func processAlertContact(methodURL string, payload io.Reader) (resp alertContact
 		return
 	}
 
-	if resp.Stat != okStatus {
-		return resp, fmt.Errorf("unexpected status `%s` when creating alert contact, error type: %s, message: %s",
-			resp.Stat, resp.Error.Type, resp.Error.Message)
-	}
+	err = checkStatus(methodName(methodURL), resp.baseResponse)
+	return
+}
 
-	return resp, nil
+func (c *Client) GetAlertContacts(ctx context.Context) (contacts []AlertContact, err error) {
+	return c.listAllAlertContacts(ctx, nil)
 }
 
-func (c *Client) GetAlertContacts() (contacts []AlertContact, err error) {
-	getURL := fmt.Sprintf("%s/getAlertContacts", baseURL)
-	payload := strings.NewReader(c.baseValues().Encode())
-	resp, err := listAlertContacts(getURL, payload)
-	if err != nil {
-		return
+// GetAlertContactsByID fetches the alert contacts with the given IDs, filtering on the server side in batches.
+func (c *Client) GetAlertContactsByID(ctx context.Context, ids []string) (contacts []AlertContact, err error) {
+	for start := 0; start < len(ids); start += pageLimit {
+		end := min(start+pageLimit, len(ids))
+		var batch []AlertContact
+		batch, err = c.listAllAlertContacts(ctx, ids[start:end])
+		if err != nil {
+			return
+		}
+
+		contacts = append(contacts, batch...)
 	}
 
-	return resp.AlertContacts, err
+	return
 }
 
-func (c *Client) GetAlertContact(contact AlertContact) (AlertContact, error) {
-	getURL := fmt.Sprintf("%s/getAlertContacts", baseURL)
-	payload := c.getAlertContactPayload(contact)
-	resp, err := listAlertContacts(getURL, payload)
+func (c *Client) GetAlertContact(ctx context.Context, contact AlertContact) (AlertContact, error) {
+	contacts, err := c.GetAlertContactsByID(ctx, []string{contact.ID})
 	if err != nil {
 		return contact, err
 	}
 
-	if len(resp.AlertContacts) != 1 {
+	if len(contacts) == 0 {
+		return contact, &APIError{
+			Method:     "getAlertContacts",
+			StatusCode: http.StatusOK,
+			Type:       ErrorTypeNotFound,
+			Message:    fmt.Sprintf("unable to find alert contact with id %s", contact.ID),
+		}
+	}
+
+	if len(contacts) != 1 {
 		return contact, fmt.Errorf("unexpected number of alert contacts return for contact ID: %s", contact.ID)
 	}
 
-	return resp.AlertContacts[0], nil
+	return contacts[0], nil
 }
 
-func (c *Client) CreateAlertContact(contact AlertContact) (out AlertContact, err error) {
-	newURL := fmt.Sprintf("%s/newAlertContact", baseURL)
+func (c *Client) CreateAlertContact(ctx context.Context, contact AlertContact) (out AlertContact, err error) {
+	newURL := fmt.Sprintf("%s/newAlertContact", c.baseURL)
 	payload := c.newAlertContactPayload(contact)
-	resp, err := processAlertContact(newURL, payload)
+	resp, err := c.processAlertContact(ctx, newURL, payload)
 	if err != nil {
 		return
 	}
@@ -180,15 +217,15 @@ func (c *Client) CreateAlertContact(contact AlertContact) (out AlertContact, err
 	return
 }
 
-func (c *Client) UpdateAlertContact(contact AlertContact) (out AlertContact, err error) {
-	fetchedContact, err := c.GetAlertContact(contact)
+func (c *Client) UpdateAlertContact(ctx context.Context, contact AlertContact) (out AlertContact, err error) {
+	fetchedContact, err := c.GetAlertContact(ctx, contact)
 	if err != nil {
 		return
 	}
 
-	editURL := fmt.Sprintf("%s/editAlertContact", baseURL)
+	editURL := fmt.Sprintf("%s/editAlertContact", c.baseURL)
 	payload := c.editContactPayload(contact)
-	resp, err := processAlertContact(editURL, payload)
+	resp, err := c.processAlertContact(ctx, editURL, payload)
 	if err != nil {
 		return
 	}
@@ -200,9 +237,9 @@ func (c *Client) UpdateAlertContact(contact AlertContact) (out AlertContact, err
 	return
 }
 
-func (c *Client) DeleteAlertContact(contact AlertContact) (err error) {
-	deleteURL := fmt.Sprintf("%s/deleteAlertContact", baseURL)
+func (c *Client) DeleteAlertContact(ctx context.Context, contact AlertContact) (err error) {
+	deleteURL := fmt.Sprintf("%s/deleteAlertContact", c.baseURL)
 	payload := c.deleteAlertContactPayload(contact)
-	_, err = processAlertContact(deleteURL, payload)
+	_, err = c.processAlertContact(ctx, deleteURL, payload)
 	return
 }
@@ -0,0 +1,83 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func alertContactPagesHandler(t *testing.T, total int, requests *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error parsing form: %v", err)
+		}
+		*requests = append(*requests, r.Form.Encode())
+
+		offset, _ := strconv.Atoi(r.Form.Get("offset"))
+		limit, _ := strconv.Atoi(r.Form.Get("limit"))
+
+		var ids []string
+		if filter := r.Form.Get("alert_contacts"); filter != "" {
+			ids = strings.Split(filter, "-")
+		} else {
+			for i := 0; i < total; i++ {
+				ids = append(ids, strconv.Itoa(i))
+			}
+		}
+
+		resp := alertContactsResponse{Offset: int64(offset), Limit: int64(limit), Total: int64(len(ids))}
+		resp.Stat = okStatus
+		for _, id := range ids[min(offset, len(ids)):min(offset+limit, len(ids))] {
+			resp.AlertContacts = append(resp.AlertContacts, AlertContact{ID: id, Type: 2, Status: 2})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("unexpected error encoding response: %v", err)
+		}
+	}
+}
+
+func TestGetAlertContactsPaginates(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, alertContactPagesHandler(t, 75, &requests))
+
+	contacts, err := c.GetAlertContacts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(contacts) != 75 {
+		t.Errorf("expected 75 alert contacts, got %d", len(contacts))
+	}
+	if len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestGetAlertContactsByIDBatches(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, alertContactPagesHandler(t, 0, &requests))
+
+	var ids []string
+	for i := 0; i < 60; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+
+	contacts, err := c.GetAlertContactsByID(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(contacts) != 60 {
+		t.Errorf("expected 60 alert contacts, got %d", len(contacts))
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if !strings.Contains(requests[1], "alert_contacts=50-51-") {
+		t.Errorf("expected second batch to start at ID 50, got request %s", requests[1])
+	}
+}
@@ -2,17 +2,52 @@ package uptimerobot
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
-	baseURL         = "https://api.uptimerobot.com/v2"
+	defaultBaseURL  = "https://api.uptimerobot.com/v2"
 	formContentType = "application/x-www-form-urlencoded"
 	jsonContentType = "application/json"
 	okStatus        = "ok"
+	// Maximum number of records the API returns for a single list request.
+	pageLimit = 50
+
+	HTTPAuthBasic  = 1
+	HTTPAuthDigest = 2
+
+	HTTPStatusDown = 0
+	HTTPStatusUp   = 1
+
+	// MonitorStatusPaused and MonitorStatusActive are the statuses editMonitor accepts, the API reports other
+	// statuses for active monitors depending on the result of their checks.
+	MonitorStatusPaused = 0
+	MonitorStatusActive = 1
+
+	KeywordCaseSensitive   = 0
+	KeywordCaseInsensitive = 1
+
+	MaintenanceWindowTypeOnce    = 1
+	MaintenanceWindowTypeDaily   = 2
+	MaintenanceWindowTypeWeekly  = 3
+	MaintenanceWindowTypeMonthly = 4
+
+	PostTypeKeyValue = 1
+	PostTypeRaw      = 2
+
+	StatusPageSortFriendlyNameAsc  = 1
+	StatusPageSortFriendlyNameDesc = 2
+	StatusPageSortStatusUpDown     = 3
+	StatusPageSortStatusDownUp     = 4
 )
 
 var (
@@ -39,6 +74,42 @@ var (
 		15: "google-chat",
 		16: "discord",
 	}
+	HTTPAuthTypes = map[int64]string{
+		HTTPAuthBasic:  "basic",
+		HTTPAuthDigest: "digest",
+	}
+	HTTPMethods = map[int64]string{
+		1: "HEAD",
+		2: "GET",
+		3: "POST",
+		4: "PUT",
+		5: "PATCH",
+		6: "DELETE",
+		7: "OPTIONS",
+	}
+	KeywordCaseTypes = map[int64]string{
+		KeywordCaseSensitive:   "case-sensitive",
+		KeywordCaseInsensitive: "case-insensitive",
+	}
+	KeywordTypes = map[int64]string{
+		1: "exists",
+		2: "not exists",
+	}
+	MaintenanceWindowTypes = map[int64]string{
+		MaintenanceWindowTypeOnce:    "once",
+		MaintenanceWindowTypeDaily:   "daily",
+		MaintenanceWindowTypeWeekly:  "weekly",
+		MaintenanceWindowTypeMonthly: "monthly",
+	}
+	MonitorSubTypes = map[int64]string{
+		1:  "http",
+		2:  "https",
+		3:  "ftp",
+		4:  "smtp",
+		5:  "pop3",
+		6:  "imap",
+		99: "custom",
+	}
 	MonitorTypes = map[string]int64{
 		"http":      1,
 		"keyword":   2,
@@ -46,6 +117,20 @@ var (
 		"port":      4,
 		"heartbeat": 5,
 	}
+	PostContentTypes = map[int64]string{
+		0: "text/html",
+		1: "application/json",
+	}
+	PostTypes = map[int64]string{
+		PostTypeKeyValue: "key-value",
+		PostTypeRaw:      "raw",
+	}
+	StatusPageSorts = map[int64]string{
+		StatusPageSortFriendlyNameAsc:  "a-z",
+		StatusPageSortFriendlyNameDesc: "z-a",
+		StatusPageSortStatusUpDown:     "up-down-paused",
+		StatusPageSortStatusDownUp:     "down-up-paused",
+	}
 )
 
 type auth struct {
@@ -55,13 +140,45 @@ type auth struct {
 type baseResponse struct {
 	Stat  string `json:"stat"`
 	Error struct {
-		Type    string `json:"type"`
-		Message string `json:"message"`
+		Type          string `json:"type"`
+		Message       string `json:"message"`
+		ParameterName string `json:"parameter_name"`
 	} `json:"error"`
 }
 
 type Client struct {
-	apiKey string
+	apiKey            string
+	baseURL           string
+	httpClient        *http.Client
+	limiter           *rateLimiter
+	maxRetries        int
+	requestsPerMinute int
+	retryMaxWait      time.Duration
+	userAgent         string
+}
+
+// Option customizes a Client created via New.
+type Option func(*Client)
+
+// WithBaseURL overrides the UptimeRobot API endpoint, e.g. for a proxy or a fake server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for issuing API requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each API request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
 }
 
 func bufferBody(a any) (io.Reader, error) {
@@ -88,10 +205,73 @@ func readRespBody(resp *http.Response) ([]byte, error) {
 	return respBody, err
 }
 
-func getRequestResp(url string, body io.Reader) ([]byte, error) {
-	resp, err := http.Post(url, jsonContentType, body)
+// sleep waits for the given duration, returning early with the context's error if it is done before.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *Client) post(ctx context.Context, url, contentType string, body io.Reader) (*http.Response, error) {
+	// Buffer the payload so that it can be sent again when retrying.
+	payload, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotent := isIdempotent(url)
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Content-Type", contentType)
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+
+		err = c.waitForRateLimit(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if resp != nil {
+			c.limiter.adjust(resp.Header)
+		}
+		if attempt >= c.maxRetries || ctx.Err() != nil || !shouldRetry(resp, err, idempotent) {
+			return resp, err
+		}
+
+		wait := c.retryWait(attempt, resp)
+		if resp != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = readRespBody(resp)
+		}
+		tflog.Debug(ctx, "Retrying UptimeRobot API request", map[string]any{
+			"attempt": attempt + 1,
+			"url":     url,
+			"wait":    wait.String(),
+		})
+
+		err = sleep(ctx, wait)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) getRequestResp(ctx context.Context, url string, body io.Reader) ([]byte, error) {
+	resp, err := c.post(ctx, url, jsonContentType, body)
 	if err != nil {
-		return nil, fmt.Errorf("error getting alert contacts: error %v", err)
+		return nil, fmt.Errorf("error calling %s: %w", methodName(url), err)
 	}
 
 	var respBody []byte
@@ -101,17 +281,16 @@ func getRequestResp(url string, body io.Reader) ([]byte, error) {
 			return nil, err
 		}
 
-		return nil, fmt.Errorf("unexpected response: status code %d, body %s, error %v",
-			resp.StatusCode, respBody, err)
+		return nil, newHTTPError(url, resp.StatusCode, respBody)
 	}
 
 	return readRespBody(resp)
 }
 
-func postForm(url string, payload io.Reader) ([]byte, error) {
-	resp, err := http.Post(url, formContentType, payload)
+func (c *Client) postForm(ctx context.Context, url string, payload io.Reader) ([]byte, error) {
+	resp, err := c.post(ctx, url, formContentType, payload)
 	if err != nil {
-		return nil, fmt.Errorf("error getting alert contacts: error %v", err)
+		return nil, fmt.Errorf("error calling %s: %w", methodName(url), err)
 	}
 
 	var respBody []byte
@@ -121,13 +300,52 @@ func postForm(url string, payload io.Reader) ([]byte, error) {
 			return nil, err
 		}
 
-		return nil, fmt.Errorf("unexpected response: status code %d, body %s, error %v",
-			resp.StatusCode, respBody, err)
+		return nil, newHTTPError(url, resp.StatusCode, respBody)
 	}
 
 	return readRespBody(resp)
 }
 
-func New(apiKey string) (*Client, error) {
-	return &Client{apiKey: apiKey}, nil
+func New(apiKey string, opts ...Option) (*Client, error) {
+	c := &Client{
+		apiKey:       apiKey,
+		baseURL:      defaultBaseURL,
+		httpClient:   http.DefaultClient,
+		maxRetries:   DefaultMaxRetries,
+		retryMaxWait: DefaultRetryMaxWait,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL %s: %v", c.baseURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %s: scheme and host are required", c.baseURL)
+	}
+	c.baseURL = strings.TrimSuffix(c.baseURL, "/")
+
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+
+	switch {
+	case c.requestsPerMinute < 0:
+		return nil, fmt.Errorf("invalid requests per minute %d: must not be negative", c.requestsPerMinute)
+	case c.requestsPerMinute == 0:
+		c.limiter = newRateLimiter(DefaultRequestsPerMinute, false)
+	default:
+		c.limiter = newRateLimiter(c.requestsPerMinute, true)
+	}
+
+	if c.maxRetries < 0 {
+		return nil, fmt.Errorf("invalid maximum number of retries %d: must not be negative", c.maxRetries)
+	}
+	if c.retryMaxWait < 0 {
+		return nil, fmt.Errorf("invalid maximum retry wait %s: must not be negative", c.retryMaxWait)
+	}
+
+	return c, nil
 }
@@ -0,0 +1,45 @@
+package uptimerobot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	defaults := []Option{
+		WithBaseURL(server.URL),
+		WithRequestsPerMinute(60_000),
+		WithRetryMaxWait(10 * time.Millisecond),
+	}
+	c, err := New("dummy", append(defaults, opts...)...)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+
+	return c
+}
+
+func TestRequestCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryMaxWait(time.Minute))
+
+	start := time.Now()
+	_, err := c.GetMonitors(ctx)
+	if err == nil {
+		t.Fatal("expected error for cancelled request")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected cancelled request not to be retried, took %s", elapsed)
+	}
+}
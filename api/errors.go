@@ -0,0 +1,105 @@
+package uptimerobot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+const (
+	ErrorTypeInvalidParameter = "invalid_parameter"
+	ErrorTypeNotFound         = "not_found"
+	ErrorTypeRateLimited      = "rate_limit"
+
+	apiKeyParameter = "api_key"
+)
+
+// APIError is returned when the UptimeRobot API rejects a request, either with an HTTP error status or with a
+// `fail` status in the response body.
+type APIError struct {
+	// Method is the API method called, e.g. getMonitors.
+	Method string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Type is the error type reported by the API, e.g. not_found or invalid_parameter.
+	Type string
+	// Message is the error message reported by the API, or the response body if it could not be decoded.
+	Message string
+	// ParameterName is the name of the offending parameter for parameter errors.
+	ParameterName string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected response calling %s: status code %d, error type: %s, message: %s",
+		e.Method, e.StatusCode, e.Type, e.Message)
+}
+
+func methodName(url string) string {
+	return path.Base(url)
+}
+
+// checkStatus returns an APIError if the decoded response does not have an ok status.
+func checkStatus(method string, resp baseResponse) error {
+	if resp.Stat == okStatus {
+		return nil
+	}
+
+	return &APIError{
+		Method:        method,
+		StatusCode:    http.StatusOK,
+		Type:          resp.Error.Type,
+		Message:       resp.Error.Message,
+		ParameterName: resp.Error.ParameterName,
+	}
+}
+
+// newHTTPError builds an APIError for an HTTP error status, using the error details from the body if present.
+func newHTTPError(url string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{Method: methodName(url), StatusCode: statusCode, Message: string(body)}
+
+	var resp baseResponse
+	if json.Unmarshal(body, &resp) == nil && resp.Error.Type != "" {
+		apiErr.Type = resp.Error.Type
+		apiErr.Message = resp.Error.Message
+		apiErr.ParameterName = resp.Error.ParameterName
+	}
+
+	return apiErr
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+// IsNotFound reports whether err indicates that the requested object does not exist. The API reports missing
+// objects with a not_found error type, an HTTP 404 status instead means that the endpoint itself does not exist,
+// e.g. due to a wrong base URL, and is not treated as a missing object.
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Type == ErrorTypeNotFound
+}
+
+// IsRateLimited reports whether err indicates that the request was rejected due to rate limiting.
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && (apiErr.StatusCode == http.StatusTooManyRequests || apiErr.Type == ErrorTypeRateLimited)
+}
+
+// IsUnauthorized reports whether err indicates that the API key is missing, invalid or lacks permissions.
+func IsUnauthorized(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	}
+
+	return apiErr.ParameterName == apiKeyParameter
+}
@@ -0,0 +1,71 @@
+package uptimerobot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+	}{
+		{"plain error", fmt.Errorf("connection refused"), false, false, false},
+		{"not found type", &APIError{StatusCode: http.StatusOK, Type: ErrorTypeNotFound}, true, false, false},
+		{"wrong endpoint status", &APIError{StatusCode: http.StatusNotFound}, false, false, false},
+		{"rate limited status", &APIError{StatusCode: http.StatusTooManyRequests}, false, true, false},
+		{"unauthorized status", &APIError{StatusCode: http.StatusUnauthorized}, false, false, true},
+		{"invalid api key", &APIError{StatusCode: http.StatusOK, Type: ErrorTypeInvalidParameter,
+			ParameterName: apiKeyParameter}, false, false, true},
+		{"wrapped", fmt.Errorf("reading monitor: %w", &APIError{Type: ErrorTypeNotFound}), true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound: expected %t, got %t", tt.notFound, got)
+			}
+			if got := IsRateLimited(tt.err); got != tt.rateLimited {
+				t.Errorf("IsRateLimited: expected %t, got %t", tt.rateLimited, got)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized: expected %t, got %t", tt.unauthorized, got)
+			}
+		})
+	}
+}
+
+func TestFailedStatusReturnsAPIError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stat":"fail","error":{"type":"not_found","parameter_name":"id","message":"monitor not found."}}`))
+	})
+
+	err := c.DeleteMonitor(context.Background(), 1)
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+
+	if apiErr.Method != "deleteMonitor" || apiErr.Message != "monitor not found." || apiErr.ParameterName != "id" {
+		t.Errorf("unexpected error details: %+v", apiErr)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetMonitorNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stat":"ok","pagination":{"offset":0,"limit":50,"total":0},"monitors":[]}`))
+	})
+
+	_, err := c.GetMonitor(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaintenanceWindow is a period during which the checks of the monitors it is assigned to are paused.
+type MaintenanceWindow struct {
+	ID           int64  `json:"id,omitempty"`
+	FriendlyName string `json:"friendly_name,omitempty"`
+	Type         int64  `json:"type,omitempty"`
+	// Value lists the days of the week (1 for Monday) or of the month (-1 for the last day) for weekly and monthly
+	// maintenance windows, separated by dashes.
+	Value string `json:"value,omitempty"`
+	// StartTime is a Unix timestamp for maintenance windows of type once and a time of day in HH:mm format
+	// otherwise.
+	StartTime string `json:"start_time,omitempty"`
+	// Duration is the length of the maintenance window in minutes.
+	Duration int64 `json:"duration,omitempty"`
+	Status   int64 `json:"status,omitempty"`
+}
+
+// UnmarshalJSON decodes a maintenance window, accepting numbers for the value and start time, which the API
+// returns for some types of maintenance windows.
+func (w *MaintenanceWindow) UnmarshalJSON(data []byte) error {
+	type maintenanceWindowAlias MaintenanceWindow
+	aux := struct {
+		*maintenanceWindowAlias
+		Value     json.RawMessage `json:"value"`
+		StartTime json.RawMessage `json:"start_time"`
+	}{maintenanceWindowAlias: (*maintenanceWindowAlias)(w)}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	w.Value = decodeLenientString(aux.Value)
+	w.StartTime = decodeLenientString(aux.StartTime)
+	return nil
+}
+
+// decodeLenientString decodes a value which may be encoded as a JSON string, a number or null.
+func decodeLenientString(raw json.RawMessage) string {
+	var str string
+	if json.Unmarshal(raw, &str) == nil {
+		return str
+	}
+
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	return string(raw)
+}
+
+type maintenanceWindowsResponse struct {
+	baseResponse
+	Pagination         pagination          `json:"pagination"`
+	MaintenanceWindows []MaintenanceWindow `json:"mwindows"`
+}
+
+type maintenanceWindowResponse struct {
+	baseResponse
+	MaintenanceWindow struct {
+		ID     int64 `json:"id"`
+		Status int64 `json:"status"`
+	} `json:"mwindow"`
+}
+
+func MaintenanceWindowTypeToString(windowType int64) (string, error) {
+	str, ok := MaintenanceWindowTypes[windowType]
+	if !ok {
+		return "", fmt.Errorf("no maintenance window type exists for type designator %d", windowType)
+	}
+
+	return str, nil
+}
+
+func MaintenanceWindowTypeToDesignator(windowType string) (int64, error) {
+	for k, v := range MaintenanceWindowTypes {
+		if v == windowType {
+			return k, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no maintenance window type designator exists for string %s", windowType)
+}
+
+// SerializeMaintenanceWindowDays encodes the days of a weekly or monthly maintenance window in the format expected
+// by the API, e.g. 1-3-5.
+func SerializeMaintenanceWindowDays(days []int64) string {
+	var encoded []string
+	for _, day := range sortedInt64s(days) {
+		encoded = append(encoded, strconv.FormatInt(day, 10))
+	}
+	return strings.Join(encoded, "-")
+}
+
+// ParseMaintenanceWindowDays decodes days serialized by SerializeMaintenanceWindowDays or returned by the API,
+// which separates the days by commas in some responses.
+func ParseMaintenanceWindowDays(serialized string) ([]int64, error) {
+	var days []int64
+	negative := false
+	for _, dayStr := range strings.Split(strings.ReplaceAll(serialized, ",", "-"), "-") {
+		dayStr = strings.TrimSpace(dayStr)
+		if dayStr == "" {
+			// An empty field is left by the minus sign of -1, which denotes the last day of the month.
+			negative = true
+			continue
+		}
+
+		day, err := strconv.ParseInt(dayStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window day %q: %w", dayStr, err)
+		}
+		if negative {
+			day = -day
+			negative = false
+		}
+		days = append(days, day)
+	}
+
+	return sortedInt64s(days), nil
+}
+
+// addMaintenanceWindowSettings adds the values shared by the newMWindow and editMWindow methods.
+func addMaintenanceWindowSettings(v url.Values, window MaintenanceWindow) {
+	v.Set("friendly_name", window.FriendlyName)
+	v.Set("start_time", window.StartTime)
+	v.Set("duration", strconv.FormatInt(window.Duration, 10))
+	if window.Value != "" {
+		v.Set("value", window.Value)
+	}
+}
+
+func (c *Client) newMaintenanceWindowPayload(window MaintenanceWindow) io.Reader {
+	v := c.baseValues()
+	v.Set("type", strconv.FormatInt(window.Type, 10))
+	addMaintenanceWindowSettings(v, window)
+	return strings.NewReader(v.Encode())
+}
+
+// editMaintenanceWindowPayload builds the editMWindow request, which does not accept the type since the type of
+// existing maintenance windows cannot be changed.
+func (c *Client) editMaintenanceWindowPayload(window MaintenanceWindow) io.Reader {
+	v := c.baseValues()
+	v.Set("id", strconv.FormatInt(window.ID, 10))
+	addMaintenanceWindowSettings(v, window)
+	return strings.NewReader(v.Encode())
+}
+
+func (c *Client) deleteMaintenanceWindowPayload(id int64) io.Reader {
+	v := c.baseValues()
+	v.Set("id", strconv.FormatInt(id, 10))
+	return strings.NewReader(v.Encode())
+}
+
+func (c *Client) getMaintenanceWindowsPayload(ids []int64, offset int64) io.Reader {
+	v := c.baseValues()
+	if len(ids) > 0 {
+		v.Set("mwindows", serializeIDs(ids))
+	}
+	v.Set("offset", strconv.FormatInt(offset, 10))
+	v.Set("limit", strconv.Itoa(pageLimit))
+	return strings.NewReader(v.Encode())
+}
+
+// listAllMaintenanceWindows fetches all pages of maintenance windows, optionally restricted to the given IDs.
+func (c *Client) listAllMaintenanceWindows(ctx context.Context, ids []int64) (windows []MaintenanceWindow, err error) {
+	getURL := fmt.Sprintf("%s/getMWindows", c.baseURL)
+	var offset int64
+	for {
+		var respBody []byte
+		respBody, err = c.postForm(ctx, getURL, c.getMaintenanceWindowsPayload(ids, offset))
+		if err != nil {
+			return
+		}
+
+		var resp maintenanceWindowsResponse
+		err = json.Unmarshal(respBody, &resp)
+		if err != nil {
+			return
+		}
+
+		err = checkStatus("getMWindows", resp.baseResponse)
+		if err != nil {
+			return
+		}
+
+		windows = append(windows, resp.MaintenanceWindows...)
+		offset += int64(len(resp.MaintenanceWindows))
+		if len(resp.MaintenanceWindows) == 0 || offset >= resp.Pagination.Total {
+			return
+		}
+	}
+}
+
+func (c *Client) processMaintenanceWindow(ctx context.Context, methodURL string, payload io.Reader) (resp maintenanceWindowResponse, err error) {
+	respBody, err := c.postForm(ctx, methodURL, payload)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(respBody, &resp)
+	if err != nil {
+		return
+	}
+
+	err = checkStatus(methodName(methodURL), resp.baseResponse)
+	return
+}
+
+func (c *Client) GetMaintenanceWindows(ctx context.Context) ([]MaintenanceWindow, error) {
+	return c.listAllMaintenanceWindows(ctx, nil)
+}
+
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id int64) (out MaintenanceWindow, err error) {
+	windows, err := c.listAllMaintenanceWindows(ctx, []int64{id})
+	if err != nil {
+		return
+	}
+
+	for _, window := range windows {
+		if window.ID == id {
+			return window, nil
+		}
+	}
+
+	return out, &APIError{
+		Method:     "getMWindows",
+		StatusCode: http.StatusOK,
+		Type:       ErrorTypeNotFound,
+		Message:    fmt.Sprintf("unable to find maintenance window with id %d", id),
+	}
+}
+
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, window MaintenanceWindow) (out MaintenanceWindow, err error) {
+	newURL := fmt.Sprintf("%s/newMWindow", c.baseURL)
+	resp, err := c.processMaintenanceWindow(ctx, newURL, c.newMaintenanceWindowPayload(window))
+	if err != nil {
+		return
+	}
+
+	out.ID = resp.MaintenanceWindow.ID
+	out.Status = resp.MaintenanceWindow.Status
+	return
+}
+
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, window MaintenanceWindow) (out MaintenanceWindow, err error) {
+	editURL := fmt.Sprintf("%s/editMWindow", c.baseURL)
+	_, err = c.processMaintenanceWindow(ctx, editURL, c.editMaintenanceWindowPayload(window))
+	if err != nil {
+		return
+	}
+
+	return c.GetMaintenanceWindow(ctx, window.ID)
+}
+
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id int64) (err error) {
+	deleteURL := fmt.Sprintf("%s/deleteMWindow", c.baseURL)
+	_, err = c.processMaintenanceWindow(ctx, deleteURL, c.deleteMaintenanceWindowPayload(id))
+	return
+}
@@ -0,0 +1,95 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestGetMaintenanceWindowsPaginates(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error parsing form: %v", err)
+		}
+
+		offset, _ := strconv.ParseInt(r.Form.Get("offset"), 10, 64)
+		limit, _ := strconv.ParseInt(r.Form.Get("limit"), 10, 64)
+		resp := maintenanceWindowsResponse{Pagination: pagination{Offset: offset, Limit: limit, Total: 60}}
+		resp.Stat = okStatus
+		for id := offset; id < min(offset+limit, 60); id++ {
+			resp.MaintenanceWindows = append(resp.MaintenanceWindows, MaintenanceWindow{ID: id, FriendlyName: fmt.Sprintf("window-%d", id)})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("unexpected error encoding response: %v", err)
+		}
+	})
+
+	windows, err := c.GetMaintenanceWindows(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(windows) != 60 {
+		t.Errorf("expected 60 maintenance windows, got %d", len(windows))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestGetMaintenanceWindowNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stat":"ok","pagination":{"offset":0,"limit":50,"total":0},"mwindows":[]}`))
+	})
+
+	_, err := c.GetMaintenanceWindow(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestMaintenanceWindowUnmarshal(t *testing.T) {
+	var window MaintenanceWindow
+	data := `{"id":1,"type":1,"friendly_name":"once","start_time":1700000000,"duration":30,"value":""}`
+	if err := json.Unmarshal([]byte(data), &window); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if window.StartTime != "1700000000" || window.Duration != 30 || window.Value != "" {
+		t.Errorf("unexpected maintenance window %+v", window)
+	}
+}
+
+func TestMaintenanceWindowDays(t *testing.T) {
+	if serialized := SerializeMaintenanceWindowDays([]int64{15, -1, 1}); serialized != "-1-1-15" {
+		t.Errorf("unexpected serialized days: %s", serialized)
+	}
+
+	tests := map[string][]int64{
+		"":        nil,
+		"1-3-5":   {1, 3, 5},
+		"1,3,5":   {1, 3, 5},
+		"-1-1-15": {-1, 1, 15},
+		"1--1":    {-1, 1},
+	}
+	for serialized, expected := range tests {
+		days, err := ParseMaintenanceWindowDays(serialized)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", serialized, err)
+		}
+		if !reflect.DeepEqual(days, expected) {
+			t.Errorf("expected %v parsing %q, got %v", expected, serialized, days)
+		}
+	}
+
+	if _, err := ParseMaintenanceWindowDays("monday"); err == nil {
+		t.Error("expected error parsing invalid days")
+	}
+}
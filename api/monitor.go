@@ -1,28 +1,300 @@
 package uptimerobot
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 )
 
+// heartbeatBaseURL is the base of the URLs heartbeat monitors receive their pings at.
+const heartbeatBaseURL = "https://heartbeat.uptimerobot.com/"
+
 type MonitorAlertContact struct {
-	ID         string `json:"ID,omitempty"`
+	ID         string `json:"id,omitempty"`
 	Threshold  int64  `json:"threshold,omitempty"`
 	Recurrence int64  `json:"recurrence,omitempty"`
 }
 
 type Monitor struct {
-	AlertContacts []MonitorAlertContact
-	FriendlyName  string `json:"friendly_name,omitempty"`
-	ID            int64  `json:"id,omitempty"`
-	Interval      int64  `json:"interval,omitempty"`
-	Status        int64  `json:"status"`
-	Timeout       int64  `json:"timeout,omitempty"`
-	Type          int64  `json:"type,omitempty"`
-	URL           string `json:"url,omitempty"`
+	AlertContacts     []MonitorAlertContact `json:"alert_contacts,omitempty"`
+	CustomHTTPHeaders map[string]string     `json:"custom_http_headers,omitempty"`
+	// CustomHTTPStatuses is decoded from the serialized form returned by the API.
+	CustomHTTPStatuses               CustomHTTPStatuses `json:"-"`
+	DisableDomainExpireNotifications bool               `json:"disable_domain_expire_notifications"`
+	FriendlyName                     string             `json:"friendly_name,omitempty"`
+	HTTPAuthType                     int64              `json:"http_auth_type,omitempty"`
+	HTTPMethod                       int64              `json:"http_method,omitempty"`
+	HTTPPassword                     string             `json:"http_password,omitempty"`
+	HTTPUsername                     string             `json:"http_username,omitempty"`
+	ID                               int64              `json:"id,omitempty"`
+	IgnoreSSLErrors                  bool               `json:"ignore_ssl_errors"`
+	Interval                         int64              `json:"interval,omitempty"`
+	KeywordCaseType                  int64              `json:"keyword_case_type"`
+	// MaintenanceWindowIDs is decoded from the maintenance windows returned by the API.
+	MaintenanceWindowIDs []int64 `json:"-"`
+	KeywordType          int64   `json:"keyword_type,omitempty"`
+	KeywordValue         string  `json:"keyword_value,omitempty"`
+	Port                 int64   `json:"port,omitempty"`
+	PostContentType      int64   `json:"post_content_type,omitempty"`
+	PostType             int64   `json:"post_type,omitempty"`
+	// PostValue is the request body, a JSON object for key-value post types and arbitrary data otherwise.
+	PostValue             string `json:"post_value,omitempty"`
+	SSLExpirationReminder bool   `json:"ssl_expiration_reminder"`
+	Status                int64  `json:"status"`
+	SubType               int64  `json:"sub_type,omitempty"`
+	Timeout               int64  `json:"timeout,omitempty"`
+	Type                  int64  `json:"type,omitempty"`
+	URL                   string `json:"url,omitempty"`
+}
+
+// HeartbeatURL returns the URL to ping for a heartbeat monitor. The API returns the heartbeat key in the url of
+// heartbeat monitors rather than the full URL.
+func (m Monitor) HeartbeatURL() string {
+	if m.URL == "" || strings.Contains(m.URL, "://") {
+		return m.URL
+	}
+
+	return heartbeatBaseURL + m.URL
+}
+
+// IsPaused reports whether the monitor's checks are paused.
+func (m Monitor) IsPaused() bool {
+	return m.Status == MonitorStatusPaused
+}
+
+// UnmarshalJSON decodes a monitor, accepting the empty strings the API returns for numeric attributes which do not
+// apply to the monitor's type, such as sub_type and port for monitors other than port monitors.
+func (m *Monitor) UnmarshalJSON(data []byte) error {
+	type monitorAlias Monitor
+	aux := struct {
+		*monitorAlias
+		CustomHTTPHeaders                json.RawMessage `json:"custom_http_headers"`
+		CustomHTTPStatuses               json.RawMessage `json:"custom_http_statuses"`
+		DisableDomainExpireNotifications json.RawMessage `json:"disable_domain_expire_notifications"`
+		IgnoreSSLErrors                  json.RawMessage `json:"ignore_ssl_errors"`
+		MaintenanceWindows               json.RawMessage `json:"mwindows"`
+		SSLExpirationReminder            json.RawMessage `json:"ssl_expiration_reminder"`
+		HTTPAuthType                     json.RawMessage `json:"http_auth_type"`
+		HTTPMethod                       json.RawMessage `json:"http_method"`
+		KeywordCaseType                  json.RawMessage `json:"keyword_case_type"`
+		KeywordType                      json.RawMessage `json:"keyword_type"`
+		PostContentType                  json.RawMessage `json:"post_content_type"`
+		PostType                         json.RawMessage `json:"post_type"`
+		PostValue                        json.RawMessage `json:"post_value"`
+		SubType                          json.RawMessage `json:"sub_type"`
+		Port                             json.RawMessage `json:"port"`
+	}{monitorAlias: (*monitorAlias)(m)}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	m.CustomHTTPHeaders, err = decodeCustomHTTPHeaders(aux.CustomHTTPHeaders)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor custom_http_headers: %w", err)
+	}
+
+	m.CustomHTTPStatuses, err = decodeCustomHTTPStatuses(aux.CustomHTTPStatuses)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor custom_http_statuses: %w", err)
+	}
+
+	m.DisableDomainExpireNotifications, err = decodeLenientBool(aux.DisableDomainExpireNotifications)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor disable_domain_expire_notifications: %w", err)
+	}
+
+	m.IgnoreSSLErrors, err = decodeLenientBool(aux.IgnoreSSLErrors)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor ignore_ssl_errors: %w", err)
+	}
+
+	m.SSLExpirationReminder, err = decodeLenientBool(aux.SSLExpirationReminder)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor ssl_expiration_reminder: %w", err)
+	}
+
+	m.MaintenanceWindowIDs, err = decodeMaintenanceWindowIDs(aux.MaintenanceWindows)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor mwindows: %w", err)
+	}
+
+	m.HTTPMethod, err = decodeLenientInt(aux.HTTPMethod)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor http_method: %w", err)
+	}
+
+	m.PostContentType, err = decodeLenientInt(aux.PostContentType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor post_content_type: %w", err)
+	}
+
+	m.PostType, err = decodeLenientInt(aux.PostType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor post_type: %w", err)
+	}
+
+	m.PostValue, err = decodePostValue(aux.PostValue)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor post_value: %w", err)
+	}
+
+	m.HTTPAuthType, err = decodeLenientInt(aux.HTTPAuthType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor http_auth_type: %w", err)
+	}
+
+	m.KeywordType, err = decodeLenientInt(aux.KeywordType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor keyword_type: %w", err)
+	}
+
+	m.KeywordCaseType, err = decodeLenientInt(aux.KeywordCaseType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor keyword_case_type: %w", err)
+	}
+
+	m.SubType, err = decodeLenientInt(aux.SubType)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor sub_type: %w", err)
+	}
+
+	m.Port, err = decodeLenientInt(aux.Port)
+	if err != nil {
+		return fmt.Errorf("error decoding monitor port: %w", err)
+	}
+
+	return nil
+}
+
+// decodeLenientInt decodes an integer which may be encoded as a JSON number, a string, an empty string or null.
+func decodeLenientInt(raw json.RawMessage) (int64, error) {
+	str := strings.Trim(string(raw), `"`)
+	if str == "" || str == "null" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(str, 10, 64)
+}
+
+// decodeLenientBool decodes a flag which may be encoded as a JSON boolean or as an integer accepted by
+// decodeLenientInt.
+func decodeLenientBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if json.Unmarshal(raw, &b) == nil {
+		return b, nil
+	}
+
+	i, err := decodeLenientInt(raw)
+	return i != 0, err
+}
+
+// decodeCustomHTTPHeaders decodes custom HTTP headers which the API returns either as a JSON object, as a JSON
+// encoded string or as an empty value when there are no custom headers.
+func decodeCustomHTTPHeaders(raw json.RawMessage) (map[string]string, error) {
+	var str string
+	if json.Unmarshal(raw, &str) == nil {
+		raw = json.RawMessage(str)
+	}
+
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" || trimmed == "[]" {
+		return nil, nil
+	}
+
+	var headers map[string]string
+	err := json.Unmarshal(raw, &headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	return headers, nil
+}
+
+func decodeCustomHTTPStatuses(raw json.RawMessage) (CustomHTTPStatuses, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return CustomHTTPStatuses{}, nil
+	}
+
+	var str string
+	err := json.Unmarshal(raw, &str)
+	if err != nil {
+		return CustomHTTPStatuses{}, err
+	}
+
+	return ParseCustomHTTPStatuses(str)
+}
+
+// decodeMaintenanceWindowIDs decodes the IDs of the maintenance windows assigned to a monitor, which the API
+// returns as a list of maintenance windows or as an empty value when there are none.
+func decodeMaintenanceWindowIDs(raw json.RawMessage) ([]int64, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	if !strings.HasPrefix(trimmed, "[") {
+		return nil, nil
+	}
+
+	var windows []struct {
+		ID json.RawMessage `json:"id"`
+	}
+	err := json.Unmarshal(raw, &windows)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for _, window := range windows {
+		id, err := decodeLenientInt(window.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return sortedInt64s(ids), nil
+}
+
+// decodePostValue decodes a post value which the API returns either as a JSON object or as a string.
+func decodePostValue(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var str string
+	if json.Unmarshal(raw, &str) == nil {
+		return str, nil
+	}
+
+	var buf bytes.Buffer
+	err := json.Compact(&buf, raw)
+	return buf.String(), err
+}
+
+// encodePostValue returns the post value in the form the API expects, a compact JSON object for key-value post
+// types and the value unchanged otherwise.
+func encodePostValue(postType int64, value string) (string, error) {
+	if postType != PostTypeKeyValue {
+		return value, nil
+	}
+
+	var object map[string]any
+	err := json.Unmarshal([]byte(value), &object)
+	if err != nil {
+		return "", fmt.Errorf("key-value post value must be a JSON object: %w", err)
+	}
+
+	out, err := json.Marshal(object)
+	return string(out), err
 }
 
 type deleteMonitorRequest struct {
@@ -30,13 +302,27 @@ type deleteMonitorRequest struct {
 	ID int64 `json:"id"`
 }
 
+type pagination struct {
+	Offset int64 `json:"offset"`
+	Limit  int64 `json:"limit"`
+	Total  int64 `json:"total"`
+}
+
 type getMonitorsResponse struct {
-	Monitors []Monitor `json:"monitors"`
+	baseResponse
+	Monitors   []Monitor  `json:"monitors"`
+	Pagination pagination `json:"pagination"`
 }
 
 type getMonitorsRequest struct {
 	auth
-	Monitors string `json:"monitors"`
+	Monitors           string `json:"monitors"`
+	Offset             int64  `json:"offset,omitempty"`
+	Limit              int64  `json:"limit,omitempty"`
+	AlertContacts      int64  `json:"alert_contacts,omitempty"`
+	CustomHTTPHeaders  int64  `json:"custom_http_headers,omitempty"`
+	CustomHTTPStatuses int64  `json:"custom_http_statuses,omitempty"`
+	MaintenanceWindows int64  `json:"mwindows,omitempty"`
 }
 
 type createMonitorResponse struct {
@@ -59,14 +345,29 @@ func (c *Client) getDeleteBody(id int64) (io.Reader, error) {
 	return bufferBody(req)
 }
 
-func (c *Client) getMonitorsRequestBody() (io.Reader, error) {
-	r := getMonitorsRequest{auth: auth{ApiKey: c.apiKey}}
+func (c *Client) getMonitorsRequestBody(offset int64) (io.Reader, error) {
+	r := getMonitorsRequest{
+		Offset:             offset,
+		Limit:              pageLimit,
+		AlertContacts:      1,
+		CustomHTTPHeaders:  1,
+		CustomHTTPStatuses: 1,
+		MaintenanceWindows: 1,
+		auth:               auth{ApiKey: c.apiKey},
+	}
 	return bufferBody(r)
 }
 
 func (c *Client) getFilteredMonitorsRequestBody(id int64) (io.Reader, error) {
 	filterId := strconv.Itoa(int(id))
-	r := getMonitorsRequest{Monitors: filterId, auth: auth{ApiKey: c.apiKey}}
+	r := getMonitorsRequest{
+		Monitors:           filterId,
+		AlertContacts:      1,
+		CustomHTTPHeaders:  1,
+		CustomHTTPStatuses: 1,
+		MaintenanceWindows: 1,
+		auth:               auth{ApiKey: c.apiKey},
+	}
 	return bufferBody(r)
 }
 
@@ -89,6 +390,86 @@ func MonitorTypeToStr(intType int64) (string, error) {
 	return "", fmt.Errorf("unable to corresponding monitor type for %d", intType)
 }
 
+func monitorMapLookup(attr string, designator int64, mapping map[int64]string) (string, error) {
+	str, ok := mapping[designator]
+	if !ok {
+		return "", fmt.Errorf("no monitor %s exists for designator %d", attr, designator)
+	}
+
+	return str, nil
+}
+
+func monitorReverseMapLookup(attr, strVal string, mapping map[int64]string) (int64, error) {
+	for k, v := range mapping {
+		if v == strVal {
+			return k, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no monitor %s designator exists for string %s", attr, strVal)
+}
+
+func MonitorSubTypeToString(subType int64) (string, error) {
+	return monitorMapLookup("sub type", subType, MonitorSubTypes)
+}
+
+func MonitorSubTypeToDesignator(subType string) (int64, error) {
+	return monitorReverseMapLookup("sub type", subType, MonitorSubTypes)
+}
+
+func HTTPAuthTypeToString(authType int64) (string, error) {
+	return monitorMapLookup("HTTP auth type", authType, HTTPAuthTypes)
+}
+
+func HTTPAuthTypeToDesignator(authType string) (int64, error) {
+	return monitorReverseMapLookup("HTTP auth type", authType, HTTPAuthTypes)
+}
+
+// IsMaskedSecret reports whether a secret returned by the API is masked rather than the actual value.
+func IsMaskedSecret(secret string) bool {
+	return secret == "" || strings.Trim(secret, "*") == ""
+}
+
+func HTTPMethodToString(method int64) (string, error) {
+	return monitorMapLookup("HTTP method", method, HTTPMethods)
+}
+
+func HTTPMethodToDesignator(method string) (int64, error) {
+	return monitorReverseMapLookup("HTTP method", method, HTTPMethods)
+}
+
+func PostTypeToString(postType int64) (string, error) {
+	return monitorMapLookup("post type", postType, PostTypes)
+}
+
+func PostTypeToDesignator(postType string) (int64, error) {
+	return monitorReverseMapLookup("post type", postType, PostTypes)
+}
+
+func PostContentTypeToString(contentType int64) (string, error) {
+	return monitorMapLookup("post content type", contentType, PostContentTypes)
+}
+
+func PostContentTypeToDesignator(contentType string) (int64, error) {
+	return monitorReverseMapLookup("post content type", contentType, PostContentTypes)
+}
+
+func KeywordTypeToString(keywordType int64) (string, error) {
+	return monitorMapLookup("keyword type", keywordType, KeywordTypes)
+}
+
+func KeywordTypeToDesignator(keywordType string) (int64, error) {
+	return monitorReverseMapLookup("keyword type", keywordType, KeywordTypes)
+}
+
+func KeywordCaseTypeToString(caseType int64) (string, error) {
+	return monitorMapLookup("keyword case type", caseType, KeywordCaseTypes)
+}
+
+func KeywordCaseTypeToDesignator(caseType string) (int64, error) {
+	return monitorReverseMapLookup("keyword case type", caseType, KeywordCaseTypes)
+}
+
 func SerializeMonitorAlertContacts(contacts []MonitorAlertContact) string {
 	var alertContacts []string
 	for _, contact := range contacts {
@@ -98,11 +479,90 @@ func SerializeMonitorAlertContacts(contacts []MonitorAlertContact) string {
 	return strings.Join(alertContacts, "-")
 }
 
-func (c *Client) newMonitorPayload(monitor Monitor) io.Reader {
-	v := c.baseValues()
+// CustomHTTPStatuses lists the HTTP status codes which mark a monitor as up or down, overriding the default
+// handling of the status code.
+type CustomHTTPStatuses struct {
+	Up   []int64
+	Down []int64
+}
+
+func (s CustomHTTPStatuses) IsEmpty() bool {
+	return len(s.Up) == 0 && len(s.Down) == 0
+}
+
+func sortedInt64s(codes []int64) []int64 {
+	sorted := slices.Clone(codes)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// SerializeCustomHTTPStatuses encodes the status codes in the format expected by the API, e.g. 404:0_200:1 for a
+// monitor which is down on 404 and up on 200.
+func SerializeCustomHTTPStatuses(statuses CustomHTTPStatuses) string {
+	var codes []string
+	for _, code := range sortedInt64s(statuses.Down) {
+		codes = append(codes, fmt.Sprintf("%d:%d", code, HTTPStatusDown))
+	}
+	for _, code := range sortedInt64s(statuses.Up) {
+		codes = append(codes, fmt.Sprintf("%d:%d", code, HTTPStatusUp))
+	}
+	return strings.Join(codes, "_")
+}
+
+// ParseCustomHTTPStatuses decodes status codes serialized by SerializeCustomHTTPStatuses or returned by the API.
+func ParseCustomHTTPStatuses(serialized string) (out CustomHTTPStatuses, err error) {
+	if strings.TrimSpace(serialized) == "" {
+		return
+	}
+
+	for _, entry := range strings.Split(serialized, "_") {
+		codeStr, statusStr, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return CustomHTTPStatuses{}, fmt.Errorf("invalid custom HTTP status %q", entry)
+		}
+
+		code, err := strconv.ParseInt(codeStr, 10, 64)
+		if err != nil {
+			return CustomHTTPStatuses{}, fmt.Errorf("invalid custom HTTP status code %q: %w", codeStr, err)
+		}
+
+		switch statusStr {
+		case strconv.Itoa(HTTPStatusDown):
+			out.Down = append(out.Down, code)
+		case strconv.Itoa(HTTPStatusUp):
+			out.Up = append(out.Up, code)
+		default:
+			return CustomHTTPStatuses{}, fmt.Errorf("invalid status %q for custom HTTP status code %d", statusStr, code)
+		}
+	}
+
+	out.Down = sortedInt64s(out.Down)
+	out.Up = sortedInt64s(out.Up)
+	return
+}
+
+// serializeIDs joins IDs by dashes, the format the API expects for lists of IDs.
+func serializeIDs(ids []int64) string {
+	encoded := make([]string, 0, len(ids))
+	for _, id := range ids {
+		encoded = append(encoded, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(encoded, "-")
+}
+
+func boolParam(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// addMonitorSettings adds the values shared by the newMonitor and editMonitor methods.
+func addMonitorSettings(v url.Values, monitor Monitor) error {
 	v.Add("friendly_name", monitor.FriendlyName)
-	v.Add("url", monitor.URL)
-	v.Add("type", strconv.FormatInt(monitor.Type, 10))
+	if monitor.URL != "" {
+		v.Add("url", monitor.URL)
+	}
 	if monitor.Interval != 0 {
 		v.Add("interval", strconv.FormatInt(monitor.Interval, 10))
 	}
@@ -112,38 +572,109 @@ func (c *Client) newMonitorPayload(monitor Monitor) io.Reader {
 	if len(monitor.AlertContacts) > 0 {
 		v.Add("alert_contacts", SerializeMonitorAlertContacts(monitor.AlertContacts))
 	}
+	if monitor.SubType != 0 {
+		v.Add("sub_type", strconv.FormatInt(monitor.SubType, 10))
+	}
+	if monitor.Port != 0 {
+		v.Add("port", strconv.FormatInt(monitor.Port, 10))
+	}
+	if monitor.HTTPUsername != "" {
+		v.Add("http_username", monitor.HTTPUsername)
+		v.Add("http_password", monitor.HTTPPassword)
+		v.Add("http_auth_type", strconv.FormatInt(monitor.HTTPAuthType, 10))
+	}
+	if monitor.HTTPMethod != 0 {
+		v.Add("http_method", strconv.FormatInt(monitor.HTTPMethod, 10))
+	}
+	if monitor.PostType != 0 {
+		postValue, err := encodePostValue(monitor.PostType, monitor.PostValue)
+		if err != nil {
+			return err
+		}
 
-	return strings.NewReader(v.Encode())
+		v.Add("post_type", strconv.FormatInt(monitor.PostType, 10))
+		v.Add("post_content_type", strconv.FormatInt(monitor.PostContentType, 10))
+		v.Add("post_value", postValue)
+	}
+	if len(monitor.CustomHTTPHeaders) > 0 {
+		headers, err := json.Marshal(monitor.CustomHTTPHeaders)
+		if err != nil {
+			return err
+		}
+		v.Add("custom_http_headers", string(headers))
+	}
+	if !monitor.CustomHTTPStatuses.IsEmpty() {
+		v.Add("custom_http_statuses", SerializeCustomHTTPStatuses(monitor.CustomHTTPStatuses))
+	}
+	if len(monitor.MaintenanceWindowIDs) > 0 {
+		v.Add("mwindows", serializeIDs(monitor.MaintenanceWindowIDs))
+	}
+	v.Add("ignore_ssl_errors", boolParam(monitor.IgnoreSSLErrors))
+	v.Add("disable_domain_expire_notifications", boolParam(monitor.DisableDomainExpireNotifications))
+	v.Add("ssl_expiration_reminder", boolParam(monitor.SSLExpirationReminder))
+	if monitor.KeywordType != 0 {
+		v.Add("keyword_type", strconv.FormatInt(monitor.KeywordType, 10))
+		v.Add("keyword_value", monitor.KeywordValue)
+		v.Add("keyword_case_type", strconv.FormatInt(monitor.KeywordCaseType, 10))
+	}
+
+	return nil
 }
 
-func (c *Client) editMonitorPayload(monitor Monitor) io.Reader {
+func (c *Client) newMonitorPayload(monitor Monitor) (io.Reader, error) {
+	v := c.baseValues()
+	v.Add("type", strconv.FormatInt(monitor.Type, 10))
+	err := addMonitorSettings(v, monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.NewReader(v.Encode()), nil
+}
+
+func (c *Client) editMonitorPayload(monitor Monitor) (io.Reader, error) {
 	v := c.baseValues()
 	v.Add("id", strconv.FormatInt(monitor.ID, 10))
-	v.Add("friendly_name", monitor.FriendlyName)
-	v.Add("url", monitor.URL)
-	v.Add("status", strconv.FormatInt(monitor.Status, 10))
-	if monitor.Interval != 0 {
-		v.Add("interval", strconv.FormatInt(monitor.Interval, 10))
+	status := int64(MonitorStatusActive)
+	if monitor.IsPaused() {
+		status = MonitorStatusPaused
 	}
-	if monitor.Timeout != 0 {
-		v.Add("timeout", strconv.FormatInt(monitor.Timeout, 10))
+	v.Add("status", strconv.FormatInt(status, 10))
+	err := addMonitorSettings(v, monitor)
+	if err != nil {
+		return nil, err
 	}
-	if len(monitor.AlertContacts) > 0 {
-		v.Add("alert_contacts", SerializeMonitorAlertContacts(monitor.AlertContacts))
+	// Clear settings which may have been removed since the monitor was created.
+	if len(monitor.CustomHTTPHeaders) == 0 {
+		v.Add("custom_http_headers", "{}")
+	}
+	if monitor.CustomHTTPStatuses.IsEmpty() {
+		v.Add("custom_http_statuses", "")
+	}
+	if len(monitor.MaintenanceWindowIDs) == 0 {
+		v.Add("mwindows", "")
+	}
+	if monitor.HTTPUsername == "" {
+		v.Add("http_username", "")
+		v.Add("http_password", "")
+	}
+	if monitor.PostType == 0 {
+		v.Add("post_type", "")
+		v.Add("post_content_type", "")
+		v.Add("post_value", "")
 	}
 
-	return strings.NewReader(v.Encode())
+	return strings.NewReader(v.Encode()), nil
 }
 
-func (c *Client) GetMonitors() (out []Monitor, err error) {
-	url := fmt.Sprintf("%s/getMonitors", baseURL)
-	body, err := c.getMonitorsRequestBody()
+func (c *Client) getMonitorsPage(ctx context.Context, offset int64) (resp getMonitorsResponse, err error) {
+	url := fmt.Sprintf("%s/getMonitors", c.baseURL)
+	body, err := c.getMonitorsRequestBody(offset)
 	if err != nil {
 		return
 	}
 
-	var resp getMonitorsResponse
-	respBody, err := getRequestResp(url, body)
+	respBody, err := c.getRequestResp(ctx, url, body)
 	if err != nil {
 		return
 	}
@@ -153,18 +684,50 @@ func (c *Client) GetMonitors() (out []Monitor, err error) {
 		return
 	}
 
-	return resp.Monitors, nil
+	err = checkStatus("getMonitors", resp.baseResponse)
+	return
+}
+
+// IterateMonitors calls yield for each monitor of the account, fetching pages as needed. Iteration stops early
+// when yield returns false.
+func (c *Client) IterateMonitors(ctx context.Context, yield func(Monitor) bool) error {
+	var offset int64
+	for {
+		resp, err := c.getMonitorsPage(ctx, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, monitor := range resp.Monitors {
+			if !yield(monitor) {
+				return nil
+			}
+		}
+
+		offset += int64(len(resp.Monitors))
+		if len(resp.Monitors) == 0 || offset >= resp.Pagination.Total {
+			return nil
+		}
+	}
+}
+
+func (c *Client) GetMonitors(ctx context.Context) (out []Monitor, err error) {
+	err = c.IterateMonitors(ctx, func(monitor Monitor) bool {
+		out = append(out, monitor)
+		return true
+	})
+	return
 }
 
-func (c *Client) GetMonitor(id int64) (out Monitor, err error) {
-	url := fmt.Sprintf("%s/getMonitors", baseURL)
+func (c *Client) GetMonitor(ctx context.Context, id int64) (out Monitor, err error) {
+	url := fmt.Sprintf("%s/getMonitors", c.baseURL)
 	body, err := c.getFilteredMonitorsRequestBody(id)
 	if err != nil {
 		return
 	}
 
 	var resp getMonitorsResponse
-	respBody, err := getRequestResp(url, body)
+	respBody, err := c.getRequestResp(ctx, url, body)
 	if err != nil {
 		return
 	}
@@ -174,19 +737,33 @@ func (c *Client) GetMonitor(id int64) (out Monitor, err error) {
 		return
 	}
 
+	err = checkStatus("getMonitors", resp.baseResponse)
+	if err != nil {
+		return
+	}
+
 	for _, monitor := range resp.Monitors {
 		if id == monitor.ID {
 			return monitor, nil
 		}
 	}
 
-	return out, fmt.Errorf("unable to find monitor with id %d", id)
+	return out, &APIError{
+		Method:     "getMonitors",
+		StatusCode: http.StatusOK,
+		Type:       ErrorTypeNotFound,
+		Message:    fmt.Sprintf("unable to find monitor with id %d", id),
+	}
 }
 
-func (c *Client) CreateMonitor(monitor Monitor) (out Monitor, err error) {
-	newUrl := fmt.Sprintf("%s/newMonitor", baseURL)
-	payload := c.newMonitorPayload(monitor)
-	respBody, err := postForm(newUrl, payload)
+func (c *Client) CreateMonitor(ctx context.Context, monitor Monitor) (out Monitor, err error) {
+	newUrl := fmt.Sprintf("%s/newMonitor", c.baseURL)
+	payload, err := c.newMonitorPayload(monitor)
+	if err != nil {
+		return
+	}
+
+	respBody, err := c.postForm(ctx, newUrl, payload)
 	if err != nil {
 		return
 	}
@@ -197,9 +774,9 @@ func (c *Client) CreateMonitor(monitor Monitor) (out Monitor, err error) {
 		return
 	}
 
-	if resp.Stat != okStatus {
-		return out, fmt.Errorf("unexpected status `%s` when creating monitor, error type: %s, message: %s",
-			resp.Stat, resp.Error.Type, resp.Error.Message)
+	err = checkStatus("newMonitor", resp.baseResponse)
+	if err != nil {
+		return
 	}
 
 	out.ID = resp.Monitor.ID
@@ -207,8 +784,8 @@ func (c *Client) CreateMonitor(monitor Monitor) (out Monitor, err error) {
 	return
 }
 
-func (c *Client) UpdateMonitor(monitor Monitor) (out Monitor, err error) {
-	existing, err := c.GetMonitor(monitor.ID)
+func (c *Client) UpdateMonitor(ctx context.Context, monitor Monitor) (out Monitor, err error) {
+	existing, err := c.GetMonitor(ctx, monitor.ID)
 	if err != nil {
 		return
 	}
@@ -217,10 +794,13 @@ func (c *Client) UpdateMonitor(monitor Monitor) (out Monitor, err error) {
 		return out, fmt.Errorf("unable to change monitor type via updating")
 	}
 
-	editURL := fmt.Sprintf("%s/editMonitor", baseURL)
-	payload := c.editMonitorPayload(monitor)
+	editURL := fmt.Sprintf("%s/editMonitor", c.baseURL)
+	payload, err := c.editMonitorPayload(monitor)
+	if err != nil {
+		return
+	}
 
-	respBody, err := postForm(editURL, payload)
+	respBody, err := c.postForm(ctx, editURL, payload)
 	if err != nil {
 		return
 	}
@@ -231,21 +811,57 @@ func (c *Client) UpdateMonitor(monitor Monitor) (out Monitor, err error) {
 		return
 	}
 
-	if resp.Stat != okStatus {
-		return out, fmt.Errorf("unexpected status `%s` when creating monitor, error type: %s, message: %s",
-			resp.Stat, resp.Error.Type, resp.Error.Message)
+	err = checkStatus("editMonitor", resp.baseResponse)
+	if err != nil {
+		return
 	}
 
-	return c.GetMonitor(monitor.ID)
+	return c.GetMonitor(ctx, monitor.ID)
 }
 
-func (c *Client) DeleteMonitor(id int64) (err error) {
-	url := fmt.Sprintf("%s/deleteMonitor", baseURL)
+// editMonitorAlertContactsPayload builds an editMonitor request which only replaces the alert contacts of the
+// monitor, leaving its other settings untouched.
+func (c *Client) editMonitorAlertContactsPayload(id int64, contacts []MonitorAlertContact) io.Reader {
+	v := c.baseValues()
+	v.Add("id", strconv.FormatInt(id, 10))
+	v.Add("alert_contacts", SerializeMonitorAlertContacts(contacts))
+	return strings.NewReader(v.Encode())
+}
+
+// UpdateMonitorAlertContacts replaces the alert contacts of the monitor with the given ID.
+func (c *Client) UpdateMonitorAlertContacts(ctx context.Context, id int64, contacts []MonitorAlertContact) (err error) {
+	editURL := fmt.Sprintf("%s/editMonitor", c.baseURL)
+	respBody, err := c.postForm(ctx, editURL, c.editMonitorAlertContactsPayload(id, contacts))
+	if err != nil {
+		return
+	}
+
+	var resp editMonitorResponse
+	err = json.Unmarshal(respBody, &resp)
+	if err != nil {
+		return
+	}
+
+	return checkStatus("editMonitor", resp.baseResponse)
+}
+
+func (c *Client) DeleteMonitor(ctx context.Context, id int64) (err error) {
+	url := fmt.Sprintf("%s/deleteMonitor", c.baseURL)
 	body, err := c.getDeleteBody(id)
 	if err != nil {
 		return
 	}
 
-	_, err = getRequestResp(url, body)
-	return
+	respBody, err := c.getRequestResp(ctx, url, body)
+	if err != nil {
+		return
+	}
+
+	var resp baseResponse
+	err = json.Unmarshal(respBody, &resp)
+	if err != nil {
+		return
+	}
+
+	return checkStatus("deleteMonitor", resp)
 }
@@ -0,0 +1,358 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func monitorPagesHandler(t *testing.T, total int64, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		var req getMonitorsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("unexpected error decoding request: %v", err)
+		}
+
+		resp := getMonitorsResponse{Pagination: pagination{Offset: req.Offset, Limit: req.Limit, Total: total}}
+		resp.Stat = okStatus
+		for id := req.Offset; id < min(req.Offset+req.Limit, total); id++ {
+			resp.Monitors = append(resp.Monitors, Monitor{ID: id, FriendlyName: fmt.Sprintf("monitor-%d", id)})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("unexpected error encoding response: %v", err)
+		}
+	}
+}
+
+func TestGetMonitorsPaginates(t *testing.T) {
+	var requests int
+	c := newTestClient(t, monitorPagesHandler(t, 123, &requests))
+
+	monitors, err := c.GetMonitors(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(monitors) != 123 {
+		t.Errorf("expected 123 monitors, got %d", len(monitors))
+	}
+	for i, monitor := range monitors {
+		if monitor.ID != int64(i) {
+			t.Fatalf("expected monitor %d to have ID %d, got %d", i, i, monitor.ID)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestIterateMonitorsStopsEarly(t *testing.T) {
+	var requests int
+	c := newTestClient(t, monitorPagesHandler(t, 123, &requests))
+
+	var seen int
+	err := c.IterateMonitors(context.Background(), func(monitor Monitor) bool {
+		seen++
+		return monitor.ID < 60
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if seen != 61 {
+		t.Errorf("expected 61 monitors to be visited, got %d", seen)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestMonitorUnmarshalLenientPort(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            string
+		subType         int64
+		port            int64
+		keywordType     int64
+		keywordCaseType int64
+	}{
+		{"empty strings", `{"id":1,"sub_type":"","port":""}`, 0, 0, 0, 0},
+		{"null", `{"id":1,"sub_type":null,"port":null}`, 0, 0, 0, 0},
+		{"numbers", `{"id":1,"sub_type":99,"port":8080}`, 99, 8080, 0, 0},
+		{"numeric strings", `{"id":1,"sub_type":"99","port":"8080"}`, 99, 8080, 0, 0},
+		{"empty keyword", `{"id":1,"type":1,"keyword_type":"","keyword_case_type":""}`, 0, 0, 0, 0},
+		{"null keyword", `{"id":1,"type":1,"keyword_type":null,"keyword_case_type":null}`, 0, 0, 0, 0},
+		{"keyword numbers", `{"id":1,"type":2,"keyword_type":2,"keyword_case_type":1}`, 0, 0, 2, 1},
+		{"keyword numeric strings", `{"id":1,"type":2,"keyword_type":"2","keyword_case_type":"1"}`, 0, 0, 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var monitor Monitor
+			if err := json.Unmarshal([]byte(tt.data), &monitor); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if monitor.ID != 1 || monitor.SubType != tt.subType || monitor.Port != tt.port {
+				t.Errorf("expected ID 1, sub type %d and port %d, got %+v", tt.subType, tt.port, monitor)
+			}
+			if monitor.KeywordType != tt.keywordType || monitor.KeywordCaseType != tt.keywordCaseType {
+				t.Errorf("expected keyword type %d and keyword case type %d, got %+v", tt.keywordType,
+					tt.keywordCaseType, monitor)
+			}
+		})
+	}
+}
+
+func TestPostValueEncoding(t *testing.T) {
+	encoded, err := encodePostValue(PostTypeKeyValue, "{\n  \"check\": \"deep\"\n}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if encoded != `{"check":"deep"}` {
+		t.Errorf("expected compact JSON object, got %s", encoded)
+	}
+
+	_, err = encodePostValue(PostTypeKeyValue, `["check"]`)
+	if err == nil {
+		t.Error("expected error encoding non-object key-value post value")
+	}
+
+	raw, err := encodePostValue(PostTypeRaw, "ping")
+	if err != nil || raw != "ping" {
+		t.Errorf("expected raw post value to be unchanged, got %s, %v", raw, err)
+	}
+
+	for _, data := range []string{`{"id":1,"post_value":{"check":"deep"}}`, `{"id":1,"post_value":"{\"check\":\"deep\"}"}`} {
+		var monitor Monitor
+		if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+			t.Fatalf("unexpected error decoding %s: %v", data, err)
+		}
+		if monitor.PostValue != `{"check":"deep"}` {
+			t.Errorf("unexpected post value decoded from %s: %s", data, monitor.PostValue)
+		}
+	}
+}
+
+func TestMonitorUnmarshalCustomHTTPHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		headers map[string]string
+	}{
+		{"object", `{"id":1,"custom_http_headers":{"X-Token":"secret"}}`, map[string]string{"X-Token": "secret"}},
+		{"encoded object", `{"id":1,"custom_http_headers":"{\"X-Token\":\"secret\"}"}`, map[string]string{"X-Token": "secret"}},
+		{"empty string", `{"id":1,"custom_http_headers":""}`, nil},
+		{"empty array", `{"id":1,"custom_http_headers":[]}`, nil},
+		{"empty object", `{"id":1,"custom_http_headers":{}}`, nil},
+		{"missing", `{"id":1}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var monitor Monitor
+			if err := json.Unmarshal([]byte(tt.data), &monitor); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(monitor.CustomHTTPHeaders, tt.headers) {
+				t.Errorf("expected headers %v, got %v", tt.headers, monitor.CustomHTTPHeaders)
+			}
+		})
+	}
+}
+
+func TestCustomHTTPStatuses(t *testing.T) {
+	statuses := CustomHTTPStatuses{Up: []int64{401, 200}, Down: []int64{404}}
+	serialized := SerializeCustomHTTPStatuses(statuses)
+	if serialized != "404:0_200:1_401:1" {
+		t.Errorf("unexpected serialized statuses: %s", serialized)
+	}
+
+	parsed, err := ParseCustomHTTPStatuses(serialized)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := CustomHTTPStatuses{Up: []int64{200, 401}, Down: []int64{404}}
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf("expected %+v, got %+v", expected, parsed)
+	}
+
+	for _, invalid := range []string{"404", "abc:0", "404:2"} {
+		if _, err := ParseCustomHTTPStatuses(invalid); err == nil {
+			t.Errorf("expected error parsing %q", invalid)
+		}
+	}
+
+	var monitor Monitor
+	if err := json.Unmarshal([]byte(`{"id":1,"custom_http_statuses":""}`), &monitor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !monitor.CustomHTTPStatuses.IsEmpty() {
+		t.Errorf("expected no custom statuses, got %+v", monitor.CustomHTTPStatuses)
+	}
+}
+
+func TestMonitorUnmarshalLenientBool(t *testing.T) {
+	for _, data := range []string{
+		`{"id":1,"ignore_ssl_errors":1,"disable_domain_expire_notifications":"1","ssl_expiration_reminder":true}`,
+		`{"id":1,"ignore_ssl_errors":true,"disable_domain_expire_notifications":1,"ssl_expiration_reminder":"1"}`,
+	} {
+		var monitor Monitor
+		if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+			t.Fatalf("unexpected error decoding %s: %v", data, err)
+		}
+		if !monitor.IgnoreSSLErrors || !monitor.DisableDomainExpireNotifications || !monitor.SSLExpirationReminder {
+			t.Errorf("expected all flags to be set decoding %s, got %+v", data, monitor)
+		}
+	}
+
+	var monitor Monitor
+	if err := json.Unmarshal([]byte(`{"id":1,"ignore_ssl_errors":0,"ssl_expiration_reminder":""}`), &monitor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if monitor.IgnoreSSLErrors || monitor.DisableDomainExpireNotifications || monitor.SSLExpirationReminder {
+		t.Errorf("expected no flags to be set, got %+v", monitor)
+	}
+}
+
+func TestHeartbeatURL(t *testing.T) {
+	monitor := Monitor{URL: "m123-abc"}
+	if url := monitor.HeartbeatURL(); url != "https://heartbeat.uptimerobot.com/m123-abc" {
+		t.Errorf("unexpected heartbeat URL for key: %s", url)
+	}
+
+	monitor.URL = "https://heartbeat.uptimerobot.com/m123-abc"
+	if url := monitor.HeartbeatURL(); url != monitor.URL {
+		t.Errorf("expected full URL to be unchanged, got %s", url)
+	}
+}
+
+func TestEditMonitorPayloadStatus(t *testing.T) {
+	c, err := New("dummy")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		status   int64
+		expected string
+	}{
+		{MonitorStatusPaused, "0"},
+		{MonitorStatusActive, "1"},
+		// Statuses reported for active monitors, e.g. up, resume the monitor.
+		{2, "1"},
+		{9, "1"},
+	}
+
+	for _, tt := range tests {
+		payload, err := c.editMonitorPayload(Monitor{ID: 1, Status: tt.status})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		body, err := io.ReadAll(payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if status := values.Get("status"); status != tt.expected {
+			t.Errorf("expected status %s for monitor status %d, got %s", tt.expected, tt.status, status)
+		}
+	}
+}
+
+func TestEditMonitorPayloadClearsRemovedSettings(t *testing.T) {
+	c, err := New("dummy")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	payload, err := c.editMonitorPayload(Monitor{ID: 1, Type: 1, URL: "http://example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body, err := io.ReadAll(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"custom_http_headers":  "{}",
+		"custom_http_statuses": "",
+		"mwindows":             "",
+		"http_username":        "",
+		"http_password":        "",
+		"post_type":            "",
+		"post_content_type":    "",
+		"post_value":           "",
+	}
+	for key, value := range expected {
+		if !values.Has(key) || values.Get(key) != value {
+			t.Errorf("expected %s to be cleared with %q, got %v", key, value, values[key])
+		}
+	}
+}
+
+func TestMonitorUnmarshalMaintenanceWindows(t *testing.T) {
+	tests := map[string][]int64{
+		`{"id":1,"mwindows":[{"id":12,"type":2},{"id":"7","type":3}]}`: {7, 12},
+		`{"id":1,"mwindows":[]}`: nil,
+		`{"id":1,"mwindows":""}`: nil,
+		`{"id":1}`:               nil,
+	}
+
+	for data, expected := range tests {
+		var monitor Monitor
+		if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+			t.Fatalf("unexpected error decoding %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(monitor.MaintenanceWindowIDs, expected) {
+			t.Errorf("expected maintenance windows %v decoding %s, got %v", expected, data, monitor.MaintenanceWindowIDs)
+		}
+	}
+}
+
+func TestUpdateMonitorAlertContacts(t *testing.T) {
+	var form url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error parsing form: %v", err)
+		}
+		form = r.Form
+
+		_, _ = w.Write([]byte(`{"stat":"ok","monitor":{"id":1}}`))
+	})
+
+	contacts := []MonitorAlertContact{{ID: "2", Threshold: 5, Recurrence: 10}, {ID: "3"}}
+	if err := c.UpdateMonitorAlertContacts(context.Background(), 1, contacts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if form.Get("id") != "1" || form.Get("alert_contacts") != "2_5_10-3_0_0" {
+		t.Errorf("unexpected form %v", form)
+	}
+	// Only the alert contacts are edited, other settings of the monitor must not be reset.
+	for _, key := range []string{"friendly_name", "status", "mwindows", "custom_http_headers"} {
+		if form.Has(key) {
+			t.Errorf("unexpected %s in form %v", key, form)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package uptimerobot
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultRequestsPerMinute matches the limit of the free plan, the limit is adjusted to the account's plan
+	// by inspecting the X-RateLimit-Limit response header unless set explicitly.
+	DefaultRequestsPerMinute = 10
+)
+
+// rateLimiter is a token bucket with a capacity of one token, spacing requests evenly so that no more than the
+// configured number of requests is sent within any one-minute window.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	// fixed is set when the limit is configured by the user and should not be adjusted from response headers.
+	fixed bool
+}
+
+func requestInterval(requestsPerMinute int) time.Duration {
+	return time.Minute / time.Duration(requestsPerMinute)
+}
+
+func newRateLimiter(requestsPerMinute int, fixed bool) *rateLimiter {
+	return &rateLimiter{interval: requestInterval(requestsPerMinute), fixed: fixed}
+}
+
+// WithRequestsPerMinute sets the maximum number of requests the client sends per minute.
+func WithRequestsPerMinute(requestsPerMinute int) Option {
+	return func(c *Client) {
+		c.requestsPerMinute = requestsPerMinute
+	}
+}
+
+// reserve takes the next available slot and returns how long the caller has to wait before sending its request.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	return wait
+}
+
+// adjust updates the limit from the X-RateLimit-Limit header, if present and the limit is not fixed.
+func (l *rateLimiter) adjust(header http.Header) {
+	if l.fixed {
+		return
+	}
+
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.interval = requestInterval(limit)
+}
+
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	wait := c.limiter.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, "Waiting for UptimeRobot API rate limit", map[string]any{"wait": wait.String()})
+	return sleep(ctx, wait)
+}
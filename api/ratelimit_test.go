@@ -0,0 +1,38 @@
+package uptimerobot
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := newRateLimiter(30, true)
+	now := time.Now()
+
+	for i, want := range []time.Duration{0, 2 * time.Second, 4 * time.Second} {
+		if got := l.reserve(now); got != want {
+			t.Errorf("reservation %d: expected wait %s, got %s", i, want, got)
+		}
+	}
+
+	if got := l.reserve(now.Add(time.Minute)); got != 0 {
+		t.Errorf("expected no wait after the limiter is idle, got %s", got)
+	}
+}
+
+func TestRateLimiterAdjust(t *testing.T) {
+	header := http.Header{"X-Ratelimit-Limit": {"600"}}
+
+	l := newRateLimiter(DefaultRequestsPerMinute, false)
+	l.adjust(header)
+	if l.interval != 100*time.Millisecond {
+		t.Errorf("expected interval to be adjusted to 100ms, got %s", l.interval)
+	}
+
+	fixed := newRateLimiter(DefaultRequestsPerMinute, true)
+	fixed.adjust(header)
+	if fixed.interval != 6*time.Second {
+		t.Errorf("expected fixed interval to stay at 6s, got %s", fixed.interval)
+	}
+}
@@ -0,0 +1,118 @@
+package uptimerobot
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = 60 * time.Second
+
+	retryBaseWait = time.Second
+	// Values of X-RateLimit-Reset above this are treated as Unix timestamps rather than seconds to wait.
+	rateLimitResetEpochThreshold = 1_000_000_000
+)
+
+// WithMaxRetries sets how many times a failed request is retried; zero disables retries.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryMaxWait caps the time to wait between two attempts of a request.
+func WithRetryMaxWait(maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.retryMaxWait = maxWait
+	}
+}
+
+// isIdempotent reports whether the API method addressed by url can be repeated without side effects. Methods
+// creating new objects (newMonitor, newAlertContact, ...) would create duplicates if a request that reached the
+// server was sent again.
+func isIdempotent(url string) bool {
+	return !strings.HasPrefix(path.Base(url), "new")
+}
+
+// isDialError reports whether err occurred before the request could be sent, which makes it safe to retry
+// any request.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func shouldRetry(resp *http.Response, err error, idempotent bool) bool {
+	if err != nil {
+		return isDialError(err) || (idempotent && isTransientError(err))
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		// Rate limited requests are rejected before being processed.
+		return true
+	}
+
+	return idempotent && resp.StatusCode >= 500
+}
+
+// retryAfter determines the wait time requested by the server, either via Retry-After or via X-RateLimit-*
+// headers once the remaining quota is exhausted.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return date.Sub(now), true
+		}
+	}
+
+	if header.Get("X-RateLimit-Remaining") != "0" {
+		return 0, false
+	}
+
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	if reset > rateLimitResetEpochThreshold {
+		return time.Unix(reset, 0).Sub(now), true
+	}
+
+	return time.Duration(reset) * time.Second, true
+}
+
+func (c *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header, time.Now()); ok {
+			return max(0, min(wait, c.retryMaxWait))
+		}
+	}
+
+	backoff := retryBaseWait << attempt
+	if backoff <= 0 || backoff > c.retryMaxWait {
+		backoff = c.retryMaxWait
+	}
+
+	// Equal jitter: wait at least half of the backoff to keep spreading out retries.
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,86 @@
+package uptimerobot
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryRateLimited(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stat":"ok","monitor":{"id":1,"status":1}}`))
+	})
+
+	monitor, err := c.CreateMonitor(context.Background(), Monitor{FriendlyName: "test", URL: "http://example.com", Type: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if monitor.ID != 1 {
+		t.Errorf("expected monitor ID 1, got %d", monitor.ID)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryServerErrorOnlyWhenIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithMaxRetries(2))
+
+	_, err := c.CreateMonitor(context.Background(), Monitor{FriendlyName: "test", URL: "http://example.com", Type: 1})
+	if err == nil {
+		t.Fatal("expected error creating monitor")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected newMonitor not to be retried, got %d calls", calls.Load())
+	}
+
+	calls.Store(0)
+	_, err = c.GetMonitors(context.Background())
+	if err == nil {
+		t.Fatal("expected error getting monitors")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected getMonitors to be retried twice, got %d calls", calls.Load())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		ok     bool
+	}{
+		{"none", http.Header{}, 0, false},
+		{"retry after seconds", http.Header{"Retry-After": {"7"}}, 7 * time.Second, true},
+		{"retry after date", http.Header{"Retry-After": {now.Add(5 * time.Second).UTC().Format(http.TimeFormat)}},
+			5 * time.Second, true},
+		{"rate limit quota left", http.Header{"X-Ratelimit-Remaining": {"3"}, "X-Ratelimit-Reset": {"30"}}, 0, false},
+		{"rate limit reset seconds", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"30"}},
+			30 * time.Second, true},
+		{"rate limit reset timestamp", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"1700000042"}},
+			42 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := retryAfter(tt.header, now)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tt.want, tt.ok, got, ok)
+			}
+		})
+	}
+}
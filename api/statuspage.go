@@ -0,0 +1,273 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// statusPageType is the only type of status page the API supports.
+	statusPageType = 1
+	// allMonitors selects all monitors of the account for a status page.
+	allMonitors = "0"
+)
+
+// StatusPage is a public status page showing the status of a set of monitors.
+type StatusPage struct {
+	ID           int64  `json:"id,omitempty"`
+	FriendlyName string `json:"friendly_name,omitempty"`
+	// MonitorIDs lists the monitors shown on the status page, all monitors of the account are shown if empty.
+	MonitorIDs   []int64 `json:"-"`
+	CustomDomain string  `json:"custom_domain,omitempty"`
+	Password     string  `json:"password,omitempty"`
+	Sort         int64   `json:"sort,omitempty"`
+	HideURLLinks bool    `json:"hide_url_links"`
+	Status       int64   `json:"status,omitempty"`
+	StandardURL  string  `json:"standard_url,omitempty"`
+	CustomURL    string  `json:"custom_url,omitempty"`
+}
+
+// UnmarshalJSON decodes a status page, accepting the different forms the API returns the monitors in and
+// deriving the custom domain from the custom URL if the API does not return it.
+func (p *StatusPage) UnmarshalJSON(data []byte) error {
+	type statusPageAlias StatusPage
+	aux := struct {
+		*statusPageAlias
+		Monitors     json.RawMessage `json:"monitors"`
+		Sort         json.RawMessage `json:"sort"`
+		HideURLLinks json.RawMessage `json:"hide_url_links"`
+	}{statusPageAlias: (*statusPageAlias)(p)}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	p.MonitorIDs, err = decodeStatusPageMonitors(aux.Monitors)
+	if err != nil {
+		return fmt.Errorf("error decoding status page monitors: %w", err)
+	}
+
+	p.Sort, err = decodeLenientInt(aux.Sort)
+	if err != nil {
+		return fmt.Errorf("error decoding status page sort: %w", err)
+	}
+
+	p.HideURLLinks, err = decodeLenientBool(aux.HideURLLinks)
+	if err != nil {
+		return fmt.Errorf("error decoding status page hide_url_links: %w", err)
+	}
+
+	if p.CustomDomain == "" && p.CustomURL != "" {
+		if customURL, err := url.Parse(p.CustomURL); err == nil {
+			p.CustomDomain = customURL.Host
+		}
+	}
+
+	return nil
+}
+
+// decodeStatusPageMonitors decodes the monitors of a status page, which the API returns either as 0 for all
+// monitors, as a list of IDs or as IDs separated by dashes.
+func decodeStatusPageMonitors(raw json.RawMessage) ([]int64, error) {
+	var ids []json.RawMessage
+	if json.Unmarshal(raw, &ids) != nil {
+		str := decodeLenientString(raw)
+		if str == "" || str == allMonitors {
+			return nil, nil
+		}
+
+		for _, id := range strings.Split(str, "-") {
+			ids = append(ids, json.RawMessage(id))
+		}
+	}
+
+	var out []int64
+	for _, raw := range ids {
+		id, err := decodeLenientInt(raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return sortedInt64s(out), nil
+}
+
+type statusPagesResponse struct {
+	baseResponse
+	Pagination  pagination   `json:"pagination"`
+	StatusPages []StatusPage `json:"psps"`
+}
+
+type statusPageResponse struct {
+	baseResponse
+	StatusPage struct {
+		ID int64 `json:"id"`
+	} `json:"psp"`
+}
+
+func StatusPageSortToString(sort int64) (string, error) {
+	str, ok := StatusPageSorts[sort]
+	if !ok {
+		return "", fmt.Errorf("no status page sort exists for designator %d", sort)
+	}
+
+	return str, nil
+}
+
+func StatusPageSortToDesignator(sort string) (int64, error) {
+	for k, v := range StatusPageSorts {
+		if v == sort {
+			return k, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no status page sort designator exists for string %s", sort)
+}
+
+// addStatusPageSettings adds the values shared by the newPSP and editPSP methods.
+func addStatusPageSettings(v url.Values, page StatusPage) {
+	v.Set("friendly_name", page.FriendlyName)
+	v.Set("monitors", allMonitors)
+	if len(page.MonitorIDs) > 0 {
+		v.Set("monitors", serializeIDs(page.MonitorIDs))
+	}
+	v.Set("custom_domain", page.CustomDomain)
+	v.Set("password", page.Password)
+	if page.Sort != 0 {
+		v.Set("sort", strconv.FormatInt(page.Sort, 10))
+	}
+	v.Set("hide_url_links", boolParam(page.HideURLLinks))
+}
+
+func (c *Client) newStatusPagePayload(page StatusPage) io.Reader {
+	v := c.baseValues()
+	v.Set("type", strconv.Itoa(statusPageType))
+	addStatusPageSettings(v, page)
+	return strings.NewReader(v.Encode())
+}
+
+func (c *Client) editStatusPagePayload(page StatusPage) io.Reader {
+	v := c.baseValues()
+	v.Set("id", strconv.FormatInt(page.ID, 10))
+	addStatusPageSettings(v, page)
+	return strings.NewReader(v.Encode())
+}
+
+func (c *Client) deleteStatusPagePayload(id int64) io.Reader {
+	v := c.baseValues()
+	v.Set("id", strconv.FormatInt(id, 10))
+	return strings.NewReader(v.Encode())
+}
+
+func (c *Client) getStatusPagesPayload(ids []int64, offset int64) io.Reader {
+	v := c.baseValues()
+	if len(ids) > 0 {
+		v.Set("psps", serializeIDs(ids))
+	}
+	v.Set("offset", strconv.FormatInt(offset, 10))
+	v.Set("limit", strconv.Itoa(pageLimit))
+	return strings.NewReader(v.Encode())
+}
+
+// listAllStatusPages fetches all pages of status pages, optionally restricted to the given IDs.
+func (c *Client) listAllStatusPages(ctx context.Context, ids []int64) (pages []StatusPage, err error) {
+	getURL := fmt.Sprintf("%s/getPSPs", c.baseURL)
+	var offset int64
+	for {
+		var respBody []byte
+		respBody, err = c.postForm(ctx, getURL, c.getStatusPagesPayload(ids, offset))
+		if err != nil {
+			return
+		}
+
+		var resp statusPagesResponse
+		err = json.Unmarshal(respBody, &resp)
+		if err != nil {
+			return
+		}
+
+		err = checkStatus("getPSPs", resp.baseResponse)
+		if err != nil {
+			return
+		}
+
+		pages = append(pages, resp.StatusPages...)
+		offset += int64(len(resp.StatusPages))
+		if len(resp.StatusPages) == 0 || offset >= resp.Pagination.Total {
+			return
+		}
+	}
+}
+
+func (c *Client) processStatusPage(ctx context.Context, methodURL string, payload io.Reader) (resp statusPageResponse, err error) {
+	respBody, err := c.postForm(ctx, methodURL, payload)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(respBody, &resp)
+	if err != nil {
+		return
+	}
+
+	err = checkStatus(methodName(methodURL), resp.baseResponse)
+	return
+}
+
+func (c *Client) GetStatusPages(ctx context.Context) ([]StatusPage, error) {
+	return c.listAllStatusPages(ctx, nil)
+}
+
+func (c *Client) GetStatusPage(ctx context.Context, id int64) (out StatusPage, err error) {
+	pages, err := c.listAllStatusPages(ctx, []int64{id})
+	if err != nil {
+		return
+	}
+
+	for _, page := range pages {
+		if page.ID == id {
+			return page, nil
+		}
+	}
+
+	return out, &APIError{
+		Method:     "getPSPs",
+		StatusCode: http.StatusOK,
+		Type:       ErrorTypeNotFound,
+		Message:    fmt.Sprintf("unable to find status page with id %d", id),
+	}
+}
+
+func (c *Client) CreateStatusPage(ctx context.Context, page StatusPage) (out StatusPage, err error) {
+	newURL := fmt.Sprintf("%s/newPSP", c.baseURL)
+	resp, err := c.processStatusPage(ctx, newURL, c.newStatusPagePayload(page))
+	if err != nil {
+		return
+	}
+
+	out.ID = resp.StatusPage.ID
+	return
+}
+
+func (c *Client) UpdateStatusPage(ctx context.Context, page StatusPage) (out StatusPage, err error) {
+	editURL := fmt.Sprintf("%s/editPSP", c.baseURL)
+	_, err = c.processStatusPage(ctx, editURL, c.editStatusPagePayload(page))
+	if err != nil {
+		return
+	}
+
+	return c.GetStatusPage(ctx, page.ID)
+}
+
+func (c *Client) DeleteStatusPage(ctx context.Context, id int64) (err error) {
+	deleteURL := fmt.Sprintf("%s/deletePSP", c.baseURL)
+	_, err = c.processStatusPage(ctx, deleteURL, c.deleteStatusPagePayload(id))
+	return
+}
@@ -0,0 +1,52 @@
+package uptimerobot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStatusPageUnmarshalMonitors(t *testing.T) {
+	tests := map[string][]int64{
+		`{"id":1,"monitors":0}`:        nil,
+		`{"id":1,"monitors":"0"}`:      nil,
+		`{"id":1,"monitors":[12,"7"]}`: {7, 12},
+		`{"id":1,"monitors":"12-7"}`:   {7, 12},
+		`{"id":1,"monitors":[]}`:       nil,
+	}
+
+	for data, expected := range tests {
+		var page StatusPage
+		if err := json.Unmarshal([]byte(data), &page); err != nil {
+			t.Fatalf("unexpected error decoding %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(page.MonitorIDs, expected) {
+			t.Errorf("expected monitors %v decoding %s, got %v", expected, data, page.MonitorIDs)
+		}
+	}
+}
+
+func TestStatusPageUnmarshalCustomDomain(t *testing.T) {
+	var page StatusPage
+	data := `{"id":1,"sort":"3","hide_url_links":1,"custom_url":"https://status.example.com/"}`
+	if err := json.Unmarshal([]byte(data), &page); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if page.CustomDomain != "status.example.com" || page.Sort != 3 || !page.HideURLLinks {
+		t.Errorf("unexpected status page %+v", page)
+	}
+}
+
+func TestGetStatusPageNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stat":"ok","pagination":{"offset":0,"limit":50,"total":0},"psps":[]}`))
+	})
+
+	_, err := c.GetStatusPage(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,4 @@
+# Lookup maintenance window with the given friendly name.
+data "uptimerobot_maintenance_window" "nightly" {
+  friendly_name = "nightly"
+}
@@ -0,0 +1,2 @@
+# List all maintenance windows.
+data "uptimerobot_maintenance_windows" "all" {}
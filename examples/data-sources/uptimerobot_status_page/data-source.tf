@@ -0,0 +1,4 @@
+# Lookup status page with the given friendly name.
+data "uptimerobot_status_page" "public" {
+  friendly_name = "public"
+}
@@ -0,0 +1,2 @@
+# List all status pages.
+data "uptimerobot_status_pages" "all" {}
@@ -0,0 +1,2 @@
+# Maintenance window can be imported by specifying the numeric identifier.
+terraform import uptimerobot_maintenance_window.example 789
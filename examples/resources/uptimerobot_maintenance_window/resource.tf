@@ -0,0 +1,8 @@
+# Pause checks every Sunday from 02:00 for two hours.
+resource "uptimerobot_maintenance_window" "example" {
+  friendly_name = "database-maintenance"
+  type          = "weekly"
+  value         = [7]
+  start_time    = "02:00"
+  duration      = 120
+}
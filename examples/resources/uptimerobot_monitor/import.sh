@@ -1,2 +1,3 @@
-# Monitor can be imported by specifying the numeric identifier.
+# Monitor can be imported by specifying the numeric identifier. Custom HTTP headers are imported as
+# sensitive_custom_http_headers, move headers without secrets to custom_http_headers after importing.
 terraform import uptimerobot_monitor.example 123
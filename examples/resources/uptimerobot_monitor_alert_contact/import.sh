@@ -0,0 +1,2 @@
+# Monitor alert contact can be imported by specifying the monitor and alert contact identifiers separated by a colon.
+terraform import uptimerobot_monitor_alert_contact.example 123:456
@@ -0,0 +1,7 @@
+# Page the on-call team when the monitor has been down for five minutes, repeating every half hour.
+resource "uptimerobot_monitor_alert_contact" "example" {
+  monitor_id       = uptimerobot_monitor.example.id
+  alert_contact_id = uptimerobot_alert_contact.on_call.id
+  threshold        = 5
+  recurrence       = 30
+}
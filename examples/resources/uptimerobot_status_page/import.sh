@@ -0,0 +1,2 @@
+# Status page can be imported by specifying the numeric identifier.
+terraform import uptimerobot_status_page.example 321
@@ -0,0 +1,6 @@
+resource "uptimerobot_status_page" "example" {
+  friendly_name = "example"
+  monitors      = [uptimerobot_monitor.example.id]
+  custom_domain = "status.example.com"
+  sort          = "down-up-paused"
+}
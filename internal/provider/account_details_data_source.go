@@ -85,7 +85,7 @@ func (d *accountDetailsDataSource) Schema(_ context.Context, _ datasource.Schema
 func (d *accountDetailsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state accountDetailsDataSourceModel
 
-	account, err := d.client.GetAccount()
+	account, err := d.client.GetAccount(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read UptimeRobot account details", err.Error())
 		return
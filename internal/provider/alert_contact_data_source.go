@@ -119,7 +119,7 @@ func (d *alertContactDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 	value := state.Value.ValueString()
 
-	alertContacts, err := d.client.GetAlertContacts()
+	alertContacts, err := d.client.GetAlertContacts(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read UptimeRobot alert contacts", err.Error())
 		return
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	uptimerobot "terraform-provider-uptimerobot/api"
 )
@@ -94,9 +95,12 @@ func (a *alertContactResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of alert contact",
+				Description: "Type of alert contact, changing it replaces the alert contact",
 				Required:    true,
 				Validators:  []validator.String{stringvalidator.OneOf(validTypes...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"value": schema.StringAttribute{
 				Description: "Alert contact's contact details",
@@ -120,7 +124,8 @@ func alertContactFromPlan(plan alertContactResourceModel) (uptimerobot.AlertCont
 	}, nil
 }
 
-func updateFromAlertContact(model alertContactResourceModel, contact *uptimerobot.AlertContact) error {
+func updateFromAlertContact(model *alertContactResourceModel, contact *uptimerobot.AlertContact) error {
+	model.ID = types.StringValue(contact.ID)
 	model.FriendlyName = types.StringValue(contact.FriendlyName)
 	model.Value = types.StringValue(contact.Value)
 
@@ -154,7 +159,7 @@ func (a *alertContactResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	contact, err := a.client.CreateAlertContact(alertContact)
+	contact, err := a.client.CreateAlertContact(ctx, alertContact)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating alert contact", err.Error())
 		return
@@ -182,39 +187,27 @@ func (a *alertContactResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	contacts, err := a.client.GetAlertContacts()
-	if err != nil {
-		resp.Diagnostics.AddError("Error getting alert contacts", err.Error())
+	contactID := state.ID.ValueString()
+	contact, err := a.client.GetAlertContact(ctx, uptimerobot.AlertContact{ID: contactID})
+	if uptimerobot.IsNotFound(err) {
+		tflog.Warn(ctx, "UptimeRobot alert contact not found, removing from state", map[string]any{"id": contactID})
+		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	stateType := state.Type.ValueString()
-	stateValue := state.Value.ValueString()
-	typeInt, err := uptimerobot.AlertContactTypeToDesignator(stateType)
 	if err != nil {
-		resp.Diagnostics.AddError("Error determining alert contacts type", err.Error())
-		return
-	}
-
-	var c *uptimerobot.AlertContact
-	for _, contact := range contacts {
-		if contact.Type == typeInt && contact.Value == stateValue {
-			c = &contact
-			break
-		}
-	}
-
-	if c == nil {
-		resp.Diagnostics.AddError("Unable to find alert contact", fmt.Sprintf(
-			"No alert contact with type %s and value %s exists", stateType, stateValue))
+		resp.Diagnostics.AddError("Error getting alert contact", fmt.Sprintf(
+			"Could not read alert contact with ID %s: %v", contactID, err))
 		return
 	}
 
-	err = updateFromAlertContact(state, c)
+	err = updateFromAlertContact(&state, &contact)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable updating state from alert contact", err.Error())
 		return
 	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (a *alertContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,7 +226,7 @@ func (a *alertContactResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	alertContact.ID = contactId
 
-	updated, err := a.client.UpdateAlertContact(alertContact)
+	updated, err := a.client.UpdateAlertContact(ctx, alertContact)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating alert contact from plan", fmt.Sprintf(
 			"Error updating alert contact for ID %s: %v", contactId, err))
@@ -265,7 +258,7 @@ func (a *alertContactResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	contactID := state.ID.ValueString()
-	err := a.client.DeleteAlertContact(uptimerobot.AlertContact{ID: contactID})
+	err := a.client.DeleteAlertContact(ctx, uptimerobot.AlertContact{ID: contactID})
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting alert contact", fmt.Sprintf(
 			"Could not delete alert contact with ID %s: %v", contactID, err))
@@ -80,7 +80,7 @@ func (d *alertContactsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 func (d *alertContactsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state alertContactsDataSourceModel
 
-	alertContacts, err := d.client.GetAlertContacts()
+	alertContacts, err := d.client.GetAlertContacts(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read UptimeRobot alert contacts", err.Error())
 		return
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ datasource.DataSource              = &maintenanceWindowDataSource{}
+	_ datasource.DataSourceWithConfigure = &maintenanceWindowDataSource{}
+)
+
+type maintenanceWindowDataSource struct {
+	client *uptimerobot.Client
+}
+
+type maintenanceWindowDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Type         types.String `tfsdk:"type"`
+	Value        types.Set    `tfsdk:"value"`
+	StartTime    types.String `tfsdk:"start_time"`
+	Duration     types.Int64  `tfsdk:"duration"`
+}
+
+func (d *maintenanceWindowDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *maintenanceWindowDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (d *maintenanceWindowDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches maintenance window for the given friendly name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the maintenance window.",
+				Computed:    true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Description: "Friendly name of the maintenance window.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "How often the maintenance window recurs.",
+				Computed:    true,
+			},
+			"value": schema.SetAttribute{
+				Description: "Days of the week or of the month the maintenance window takes place on.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Start of the maintenance window, an RFC 3339 timestamp for maintenance windows of type " +
+					"once and a time of day in HH:mm format otherwise.",
+				Computed: true,
+			},
+			"duration": schema.Int64Attribute{
+				Description: "Duration of the maintenance window (minutes).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapMaintenanceWindowToState(window uptimerobot.MaintenanceWindow, state *maintenanceWindowDataSourceModel) error {
+	windowType, err := uptimerobot.MaintenanceWindowTypeToString(window.Type)
+	if err != nil {
+		return err
+	}
+
+	days, err := uptimerobot.ParseMaintenanceWindowDays(window.Value)
+	if err != nil {
+		return err
+	}
+
+	startTime, err := formatMaintenanceWindowStartTime(window)
+	if err != nil {
+		return err
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(window.ID, 10))
+	state.FriendlyName = types.StringValue(window.FriendlyName)
+	state.Type = types.StringValue(windowType)
+	state.Value = int64SetValue(days)
+	state.StartTime = types.StringValue(startTime)
+	state.Duration = types.Int64Value(window.Duration)
+
+	return nil
+}
+
+func (d *maintenanceWindowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state maintenanceWindowDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	friendlyName := state.FriendlyName.ValueString()
+
+	maintenanceWindows, err := d.client.GetMaintenanceWindows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read UptimeRobot maintenance windows", err.Error())
+		return
+	}
+
+	for _, window := range maintenanceWindows {
+		if window.FriendlyName != friendlyName {
+			continue
+		}
+
+		err = mapMaintenanceWindowToState(window, &state)
+		if err == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		} else {
+			resp.Diagnostics.AddError("Error mapping maintenance window to state", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.AddError("No maintenance window found",
+		fmt.Sprintf("Unable to locate maintenance window with friendly name %s", friendlyName))
+}
+
+func NewMaintenanceWindowDataSource() datasource.DataSource {
+	return &maintenanceWindowDataSource{}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenanceWindowDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test"
+  type = "weekly"
+  value = [1, 3]
+  start_time = "22:00"
+  duration = 60
+}
+
+data "uptimerobot_maintenance_window" "test" {
+  friendly_name = uptimerobot_maintenance_window.test.friendly_name
+}
+
+data "uptimerobot_maintenance_windows" "test" {
+  depends_on = [uptimerobot_maintenance_window.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.uptimerobot_maintenance_window.test", "id",
+						"uptimerobot_maintenance_window.test", "id"),
+					resource.TestCheckResourceAttr("data.uptimerobot_maintenance_window.test", "type", "weekly"),
+					resource.TestCheckResourceAttr("data.uptimerobot_maintenance_window.test", "value.#", "2"),
+					resource.TestCheckResourceAttr("data.uptimerobot_maintenance_window.test", "start_time", "22:00"),
+					resource.TestCheckResourceAttr("data.uptimerobot_maintenance_window.test", "duration", "60"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.uptimerobot_maintenance_windows.test",
+						"maintenance_windows.*", map[string]string{"friendly_name": "test", "type": "weekly"}),
+				),
+			},
+		},
+	})
+}
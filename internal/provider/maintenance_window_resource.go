@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ resource.Resource                   = &maintenanceWindowResource{}
+	_ resource.ResourceWithConfigure      = &maintenanceWindowResource{}
+	_ resource.ResourceWithImportState    = &maintenanceWindowResource{}
+	_ resource.ResourceWithValidateConfig = &maintenanceWindowResource{}
+)
+
+const (
+	onceMaintenanceWindowType    = "once"
+	weeklyMaintenanceWindowType  = "weekly"
+	monthlyMaintenanceWindowType = "monthly"
+	lastDayOfMonth               = -1
+)
+
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+type maintenanceWindowResourceModel struct {
+	Duration     types.Int64  `tfsdk:"duration"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	ID           types.String `tfsdk:"id"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+	StartTime    types.String `tfsdk:"start_time"`
+	Type         types.String `tfsdk:"type"`
+	Value        types.Set    `tfsdk:"value"`
+}
+
+type maintenanceWindowResource struct {
+	client *uptimerobot.Client
+}
+
+func NewMaintenanceWindowResource() resource.Resource {
+	return &maintenanceWindowResource{}
+}
+
+func (r *maintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *maintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *maintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *maintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var validTypes []string
+	for _, t := range uptimerobot.MaintenanceWindowTypes {
+		validTypes = append(validTypes, t)
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a maintenance window.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the maintenance window.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the maintenance window.",
+				Computed:    true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Description: "Friendly name of the maintenance window",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "How often the maintenance window recurs, changing it replaces the maintenance window",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(validTypes...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.SetAttribute{
+				Description: "Days the maintenance window takes place on, days of the week from 1 (Monday) to 7 " +
+					"(Sunday) for weekly maintenance windows and days of the month from 1 to 31 or -1 for the last " +
+					"day of the month for monthly maintenance windows",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Start of the maintenance window, an RFC 3339 timestamp for maintenance windows of type " +
+					"once and a time of day in HH:mm format otherwise",
+				Required: true,
+			},
+			"duration": schema.Int64Attribute{
+				Description: "Duration of the maintenance window (minutes)",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *maintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config maintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	windowType := config.Type.ValueString()
+	switch {
+	case config.StartTime.IsUnknown():
+	case windowType == onceMaintenanceWindowType:
+		if _, err := time.Parse(time.RFC3339, config.StartTime.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid maintenance window attribute",
+				fmt.Sprintf("Attribute start_time must be an RFC 3339 timestamp for maintenance windows of type once: %v", err))
+		}
+	case !timeOfDayRegexp.MatchString(config.StartTime.ValueString()):
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid maintenance window attribute",
+			fmt.Sprintf("Attribute start_time must be a time of day in HH:mm format for %s maintenance windows.", windowType))
+	}
+
+	if windowType != weeklyMaintenanceWindowType && windowType != monthlyMaintenanceWindowType {
+		if !config.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid maintenance window attribute",
+				"Attribute value can only be set for weekly and monthly maintenance windows.")
+		}
+		return
+	}
+
+	if config.Value.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Missing maintenance window attribute",
+			fmt.Sprintf("Attribute value is required for %s maintenance windows.", windowType))
+		return
+	}
+
+	for _, day := range int64SetFromValue(config.Value) {
+		valid := day >= 1 && day <= 7
+		if windowType == monthlyMaintenanceWindowType {
+			valid = (day >= 1 && day <= 31) || day == lastDayOfMonth
+		}
+
+		if !valid {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid maintenance window attribute",
+				fmt.Sprintf("Day %d is not valid for %s maintenance windows.", day, windowType))
+		}
+	}
+}
+
+func maintenanceWindowFromPlan(plan maintenanceWindowResourceModel) (uptimerobot.MaintenanceWindow, error) {
+	window := uptimerobot.MaintenanceWindow{
+		FriendlyName: plan.FriendlyName.ValueString(),
+		StartTime:    plan.StartTime.ValueString(),
+		Duration:     plan.Duration.ValueInt64(),
+		Value:        uptimerobot.SerializeMaintenanceWindowDays(int64SetFromValue(plan.Value)),
+	}
+
+	windowType, err := uptimerobot.MaintenanceWindowTypeToDesignator(plan.Type.ValueString())
+	if err != nil {
+		return window, err
+	}
+	window.Type = windowType
+
+	if windowType == uptimerobot.MaintenanceWindowTypeOnce {
+		startTime, err := time.Parse(time.RFC3339, plan.StartTime.ValueString())
+		if err != nil {
+			return window, err
+		}
+		window.StartTime = strconv.FormatInt(startTime.Unix(), 10)
+	}
+
+	return window, nil
+}
+
+// formatMaintenanceWindowStartTime returns the start time of the maintenance window as an RFC 3339 timestamp for
+// maintenance windows of type once and as the time of day returned by the API otherwise.
+func formatMaintenanceWindowStartTime(window uptimerobot.MaintenanceWindow) (string, error) {
+	if window.Type != uptimerobot.MaintenanceWindowTypeOnce {
+		return window.StartTime, nil
+	}
+
+	unix, err := strconv.ParseInt(window.StartTime, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid start time %q: %w", window.StartTime, err)
+	}
+
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339), nil
+}
+
+func updateFromMaintenanceWindow(model *maintenanceWindowResourceModel, window uptimerobot.MaintenanceWindow) error {
+	windowType, err := uptimerobot.MaintenanceWindowTypeToString(window.Type)
+	if err != nil {
+		return err
+	}
+
+	days, err := uptimerobot.ParseMaintenanceWindowDays(window.Value)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringValue(strconv.FormatInt(window.ID, 10))
+	model.FriendlyName = types.StringValue(window.FriendlyName)
+	model.Type = types.StringValue(windowType)
+	model.Duration = types.Int64Value(window.Duration)
+	model.Value = int64SetValue(days)
+
+	startTime, err := formatMaintenanceWindowStartTime(window)
+	if err != nil {
+		return err
+	}
+
+	// Keep the configured timestamp, which may use a different time zone, unless the start time changed.
+	known, knownErr := time.Parse(time.RFC3339, model.StartTime.ValueString())
+	parsed, parsedErr := time.Parse(time.RFC3339, startTime)
+	if knownErr != nil || parsedErr != nil || !known.Equal(parsed) {
+		model.StartTime = types.StringValue(startTime)
+	}
+
+	return nil
+}
+
+func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := maintenanceWindowFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating maintenance window",
+			"Could not create maintenance window from plan: "+err.Error())
+		return
+	}
+
+	created, err := r.client.CreateMaintenanceWindow(ctx, window)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating maintenance window",
+			"Could not create maintenance window, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(created.ID, 10))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot maintenance window",
+			fmt.Sprintf("Could not determine ID of the maintenance window %s: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	window, err := r.client.GetMaintenanceWindow(ctx, id)
+	if uptimerobot.IsNotFound(err) {
+		tflog.Warn(ctx, "UptimeRobot maintenance window not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot maintenance window",
+			fmt.Sprintf("Could not read UptimeRobot maintenance window with ID %d: %v", id, err))
+		return
+	}
+
+	err = updateFromMaintenanceWindow(&state, window)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot maintenance window",
+			fmt.Sprintf("Could not map UptimeRobot maintenance window with ID %d to state: %v", id, err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := maintenanceWindowFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating maintenance window",
+			fmt.Sprintf("Could not update maintenance window %v", err))
+		return
+	}
+
+	window.ID, err = strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating maintenance window",
+			fmt.Sprintf("Could not determine maintenance window ID %v", err))
+		return
+	}
+
+	_, err = r.client.UpdateMaintenanceWindow(ctx, window)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating maintenance window",
+			fmt.Sprintf("Could not update maintenance window %v", err))
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *maintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting maintenance window",
+			fmt.Sprintf("Could not determine maintenance window ID: %v", err))
+		return
+	}
+
+	err = r.client.DeleteMaintenanceWindow(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting maintenance window",
+			fmt.Sprintf("Could not delete maintenance window with ID %d: %v", id, err))
+		return
+	}
+}
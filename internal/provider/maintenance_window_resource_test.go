@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenanceWindowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test"
+  type = "weekly"
+  value = [1, 7]
+  start_time = "02:00"
+  duration = 60
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "friendly_name", "test"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "type", "weekly"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "value.#", "2"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "start_time", "02:00"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "duration", "60"),
+					resource.TestCheckResourceAttrSet("uptimerobot_maintenance_window.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_maintenance_window.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test1"
+  type = "weekly"
+  value = [7]
+  start_time = "03:30"
+  duration = 120
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "friendly_name", "test1"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "value.#", "1"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "start_time", "03:30"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "duration", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOnceMaintenanceWindowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test"
+  type = "once"
+  start_time = "2030-01-01T02:00:00Z"
+  duration = 30
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "type", "once"),
+					resource.TestCheckResourceAttr("uptimerobot_maintenance_window.test", "start_time", "2030-01-01T02:00:00Z"),
+					resource.TestCheckNoResourceAttr("uptimerobot_maintenance_window.test", "value"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_maintenance_window.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ datasource.DataSource              = &maintenanceWindowsDataSource{}
+	_ datasource.DataSourceWithConfigure = &maintenanceWindowsDataSource{}
+)
+
+type maintenanceWindowsDataSource struct {
+	client *uptimerobot.Client
+}
+
+type maintenanceWindowsDataSourceModel struct {
+	MaintenanceWindows []maintenanceWindowDataSourceModel `tfsdk:"maintenance_windows"`
+}
+
+func (d *maintenanceWindowsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *maintenanceWindowsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_windows"
+}
+
+func (d *maintenanceWindowsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches maintenance windows defined for the account",
+		Attributes: map[string]schema.Attribute{
+			"maintenance_windows": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the maintenance window.",
+							Computed:    true,
+						},
+						"friendly_name": schema.StringAttribute{
+							Description: "Friendly name of the maintenance window.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "How often the maintenance window recurs.",
+							Computed:    true,
+						},
+						"value": schema.SetAttribute{
+							Description: "Days of the week or of the month the maintenance window takes place on.",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Start of the maintenance window, an RFC 3339 timestamp for maintenance windows of " +
+								"type once and a time of day in HH:mm format otherwise.",
+							Computed: true,
+						},
+						"duration": schema.Int64Attribute{
+							Description: "Duration of the maintenance window (minutes).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}}
+}
+
+func (d *maintenanceWindowsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state maintenanceWindowsDataSourceModel
+
+	maintenanceWindows, err := d.client.GetMaintenanceWindows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read UptimeRobot maintenance windows", err.Error())
+		return
+	}
+
+	for _, window := range maintenanceWindows {
+		var data maintenanceWindowDataSourceModel
+		err = mapMaintenanceWindowToState(window, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Error mapping maintenance window to state", err.Error())
+		}
+		state.MaintenanceWindows = append(state.MaintenanceWindows, data)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func NewMaintenanceWindowsDataSource() datasource.DataSource {
+	return &maintenanceWindowsDataSource{}
+}
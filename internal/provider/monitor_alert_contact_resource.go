@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ resource.Resource                = &monitorAlertContactResource{}
+	_ resource.ResourceWithConfigure   = &monitorAlertContactResource{}
+	_ resource.ResourceWithImportState = &monitorAlertContactResource{}
+)
+
+var numericIDRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// monitorLocks serializes changes to the alert contacts of a monitor, which are read, modified and written back
+// as a whole, so that associations with the same monitor created in parallel do not overwrite each other.
+var monitorLocks sync.Map
+
+func lockMonitor(id int64) func() {
+	mu, _ := monitorLocks.LoadOrStore(id, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+type monitorAlertContactResourceModel struct {
+	AlertContactID types.String `tfsdk:"alert_contact_id"`
+	ID             types.String `tfsdk:"id"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+	MonitorID      types.String `tfsdk:"monitor_id"`
+	Recurrence     types.Int64  `tfsdk:"recurrence"`
+	Threshold      types.Int64  `tfsdk:"threshold"`
+}
+
+type monitorAlertContactResource struct {
+	client *uptimerobot.Client
+}
+
+func NewMonitorAlertContactResource() resource.Resource {
+	return &monitorAlertContactResource{}
+}
+
+// parseMonitorAlertContactID splits the identifier of an association into the monitor ID and the alert contact ID.
+func parseMonitorAlertContactID(id string) (int64, string, error) {
+	monitorID, contactID, ok := strings.Cut(id, ":")
+	if !ok || !numericIDRegexp.MatchString(monitorID) || !numericIDRegexp.MatchString(contactID) {
+		return 0, "", fmt.Errorf("expected identifier in the format monitorID:contactID, got %q", id)
+	}
+
+	parsed, err := strconv.ParseInt(monitorID, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return parsed, contactID, nil
+}
+
+func (r *monitorAlertContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	monitorID, contactID, err := parseMonitorAlertContactID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), strconv.FormatInt(monitorID, 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alert_contact_id"), contactID)...)
+}
+
+func (r *monitorAlertContactResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *monitorAlertContactResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_alert_contact"
+}
+
+func (r *monitorAlertContactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches an alert contact to a monitor, keeping the other alert contacts of the monitor.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the association in the format monitorID:contactID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the association.",
+				Computed:    true,
+			},
+			"monitor_id": schema.StringAttribute{
+				Description: "Identifier of the monitor, changing it replaces the association",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(numericIDRegexp, "must be the identifier of a monitor"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alert_contact_id": schema.StringAttribute{
+				Description: "Identifier of the alert contact, changing it replaces the association",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(numericIDRegexp, "must be the identifier of an alert contact"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"threshold": schema.Int64Attribute{
+				Description: "Threshold for alerting (minutes)",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"recurrence": schema.Int64Attribute{
+				Description: "Repetition interval for alerts (minutes)",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	}
+}
+
+func monitorAlertContactFromModel(model monitorAlertContactResourceModel) uptimerobot.MonitorAlertContact {
+	return uptimerobot.MonitorAlertContact{
+		ID:         model.AlertContactID.ValueString(),
+		Threshold:  model.Threshold.ValueInt64(),
+		Recurrence: model.Recurrence.ValueInt64(),
+	}
+}
+
+// editAlertContacts replaces the alert contacts of the monitor with the result of edit, which is passed the
+// current alert contacts of the monitor.
+func (r *monitorAlertContactResource) editAlertContacts(ctx context.Context, monitorID int64,
+	edit func([]uptimerobot.MonitorAlertContact) ([]uptimerobot.MonitorAlertContact, error)) error {
+	unlock := lockMonitor(monitorID)
+	defer unlock()
+
+	monitor, err := r.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return err
+	}
+
+	contacts, err := edit(monitor.AlertContacts)
+	if err != nil {
+		return err
+	}
+
+	return r.client.UpdateMonitorAlertContacts(ctx, monitorID, contacts)
+}
+
+func (r *monitorAlertContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan monitorAlertContactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, err := strconv.ParseInt(plan.MonitorID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating monitor alert contact",
+			fmt.Sprintf("Could not determine monitor ID %v", err))
+		return
+	}
+
+	contact := monitorAlertContactFromModel(plan)
+	err = r.editAlertContacts(ctx, monitorID, func(contacts []uptimerobot.MonitorAlertContact) ([]uptimerobot.MonitorAlertContact, error) {
+		for _, existing := range contacts {
+			if existing.ID == contact.ID {
+				return nil, fmt.Errorf("alert contact %s is already attached to monitor %d, import the association "+
+					"to manage it", contact.ID, monitorID)
+			}
+		}
+		return append(contacts, contact), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating monitor alert contact",
+			"Could not attach alert contact to monitor, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%s", monitorID, contact.ID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *monitorAlertContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state monitorAlertContactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, contactID, err := parseMonitorAlertContactID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot monitor alert contact",
+			fmt.Sprintf("Could not determine ID of the monitor alert contact %s: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	monitor, err := r.client.GetMonitor(ctx, monitorID)
+	if uptimerobot.IsNotFound(err) {
+		tflog.Warn(ctx, "UptimeRobot monitor not found, removing alert contact from state", map[string]any{"id": monitorID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot monitor alert contact",
+			fmt.Sprintf("Could not read UptimeRobot monitor with ID %d: %v", monitorID, err))
+		return
+	}
+
+	for _, contact := range monitor.AlertContacts {
+		if contact.ID != contactID {
+			continue
+		}
+
+		state.MonitorID = types.StringValue(strconv.FormatInt(monitorID, 10))
+		state.AlertContactID = types.StringValue(contact.ID)
+		state.Threshold = types.Int64Value(contact.Threshold)
+		state.Recurrence = types.Int64Value(contact.Recurrence)
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Warn(ctx, "UptimeRobot alert contact not attached to monitor, removing from state",
+		map[string]any{"monitor_id": monitorID, "alert_contact_id": contactID})
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *monitorAlertContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan monitorAlertContactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, _, err := parseMonitorAlertContactID(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating monitor alert contact",
+			fmt.Sprintf("Could not determine monitor alert contact ID %v", err))
+		return
+	}
+
+	contact := monitorAlertContactFromModel(plan)
+	err = r.editAlertContacts(ctx, monitorID, func(contacts []uptimerobot.MonitorAlertContact) ([]uptimerobot.MonitorAlertContact, error) {
+		for i, existing := range contacts {
+			if existing.ID == contact.ID {
+				contacts[i] = contact
+				return contacts, nil
+			}
+		}
+		// The alert contact was detached outside of Terraform since it was last read.
+		return append(contacts, contact), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating monitor alert contact",
+			fmt.Sprintf("Could not update monitor alert contact %v", err))
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *monitorAlertContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state monitorAlertContactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, contactID, err := parseMonitorAlertContactID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting monitor alert contact",
+			fmt.Sprintf("Could not determine monitor alert contact ID: %v", err))
+		return
+	}
+
+	err = r.editAlertContacts(ctx, monitorID, func(contacts []uptimerobot.MonitorAlertContact) ([]uptimerobot.MonitorAlertContact, error) {
+		var remaining []uptimerobot.MonitorAlertContact
+		for _, contact := range contacts {
+			if contact.ID != contactID {
+				remaining = append(remaining, contact)
+			}
+		}
+		return remaining, nil
+	})
+	if uptimerobot.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting monitor alert contact",
+			fmt.Sprintf("Could not detach alert contact %s from monitor %d: %v", contactID, monitorID, err))
+		return
+	}
+}
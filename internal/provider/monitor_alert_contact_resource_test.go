@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const monitorAlertContactConfig = `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "test"
+  type = "e-mail"
+  value = "test@example.com"
+}
+`
+
+func TestParseMonitorAlertContactID(t *testing.T) {
+	monitorID, contactID, err := parseMonitorAlertContactID("123:456")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if monitorID != 123 || contactID != "456" {
+		t.Errorf("unexpected identifiers %d and %s", monitorID, contactID)
+	}
+
+	for _, id := range []string{"", "123", "123:", ":456", "abc:456", "123:456:789"} {
+		if _, _, err := parseMonitorAlertContactID(id); err == nil {
+			t.Errorf("expected error parsing %q", id)
+		}
+	}
+}
+
+func TestAccMonitorAlertContactResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + monitorAlertContactConfig + `
+resource "uptimerobot_monitor_alert_contact" "test" {
+  monitor_id = uptimerobot_monitor.test.id
+  alert_contact_id = uptimerobot_alert_contact.test.id
+  threshold = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("uptimerobot_monitor_alert_contact.test", "monitor_id",
+						"uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttrPair("uptimerobot_monitor_alert_contact.test", "alert_contact_id",
+						"uptimerobot_alert_contact.test", "id"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "threshold", "5"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "recurrence", "0"),
+					resource.TestCheckResourceAttrSet("uptimerobot_monitor_alert_contact.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor_alert_contact.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				// Importing the monitor must not adopt the association, which would detach it on the next apply.
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + monitorAlertContactConfig + `
+resource "uptimerobot_monitor_alert_contact" "test" {
+  monitor_id = uptimerobot_monitor.test.id
+  alert_contact_id = uptimerobot_alert_contact.test.id
+  threshold = 10
+  recurrence = 30
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "threshold", "10"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor_alert_contact.test", "recurrence", "30"),
+					// The monitor does not manage its alert contacts, so the association is not reported as a change.
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "alert_contact.#", "0"),
+				),
+			},
+		},
+	})
+}
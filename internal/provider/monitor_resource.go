@@ -2,28 +2,47 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	uptimerobot "terraform-provider-uptimerobot/api"
 )
 
 var (
-	_ resource.Resource                = &monitorResource{}
-	_ resource.ResourceWithConfigure   = &monitorResource{}
-	_ resource.ResourceWithImportState = &monitorResource{}
+	_ resource.Resource                   = &monitorResource{}
+	_ resource.ResourceWithConfigure      = &monitorResource{}
+	_ resource.ResourceWithImportState    = &monitorResource{}
+	_ resource.ResourceWithModifyPlan     = &monitorResource{}
+	_ resource.ResourceWithValidateConfig = &monitorResource{}
+)
+
+const (
+	heartbeatMonitorType = "heartbeat"
+	httpMonitorType      = "http"
+	keywordMonitorType   = "keyword"
+	portMonitorType      = "port"
+	customPortSubType    = "custom"
 )
 
 type monitorAlertContact struct {
@@ -32,15 +51,41 @@ type monitorAlertContact struct {
 	Recurrence types.Int64  `tfsdk:"recurrence"`
 }
 
+type monitorCustomHTTPStatuses struct {
+	Up   types.Set `tfsdk:"up"`
+	Down types.Set `tfsdk:"down"`
+}
+
 type monitorResourceModel struct {
-	FriendlyName  types.String          `tfsdk:"friendly_name"`
-	ID            types.String          `tfsdk:"id"`
-	Interval      types.Int64           `tfsdk:"interval"`
-	LastUpdated   types.String          `tfsdk:"last_updated"`
-	Timeout       types.Int64           `tfsdk:"timeout"`
-	Type          types.String          `tfsdk:"type"`
-	URL           types.String          `tfsdk:"url"`
-	AlertContacts []monitorAlertContact `tfsdk:"alert_contact"`
+	CustomHTTPHeaders                types.Map                  `tfsdk:"custom_http_headers"`
+	SensitiveCustomHTTPHeaders       types.Map                  `tfsdk:"sensitive_custom_http_headers"`
+	CustomHTTPStatuses               *monitorCustomHTTPStatuses `tfsdk:"custom_http_statuses"`
+	DisableDomainExpireNotifications types.Bool                 `tfsdk:"disable_domain_expire_notifications"`
+	FriendlyName                     types.String               `tfsdk:"friendly_name"`
+	HeartbeatURL                     types.String               `tfsdk:"heartbeat_url"`
+	HTTPAuthType                     types.String               `tfsdk:"http_auth_type"`
+	HTTPPassword                     types.String               `tfsdk:"http_password"`
+	HTTPMethod                       types.String               `tfsdk:"http_method"`
+	HTTPUsername                     types.String               `tfsdk:"http_username"`
+	ID                               types.String               `tfsdk:"id"`
+	IgnoreSSLErrors                  types.Bool                 `tfsdk:"ignore_ssl_errors"`
+	Interval                         types.Int64                `tfsdk:"interval"`
+	LastUpdated                      types.String               `tfsdk:"last_updated"`
+	Timeout                          types.Int64                `tfsdk:"timeout"`
+	Type                             types.String               `tfsdk:"type"`
+	URL                              types.String               `tfsdk:"url"`
+	KeywordCaseType                  types.String               `tfsdk:"keyword_case_type"`
+	KeywordType                      types.String               `tfsdk:"keyword_type"`
+	KeywordValue                     types.String               `tfsdk:"keyword_value"`
+	MaintenanceWindowIDs             types.Set                  `tfsdk:"maintenance_window_ids"`
+	Paused                           types.Bool                 `tfsdk:"paused"`
+	Port                             types.Int64                `tfsdk:"port"`
+	PostContentType                  types.String               `tfsdk:"post_content_type"`
+	PostType                         types.String               `tfsdk:"post_type"`
+	PostValue                        types.String               `tfsdk:"post_value"`
+	SSLExpirationReminder            types.Bool                 `tfsdk:"ssl_expiration_reminder"`
+	SubType                          types.String               `tfsdk:"sub_type"`
+	AlertContacts                    []monitorAlertContact      `tfsdk:"alert_contact"`
 }
 
 type monitorResource struct {
@@ -82,6 +127,46 @@ func (r *monitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 		validMonitorTypes = append(validMonitorTypes, t)
 	}
 
+	var validSubTypes []string
+	for _, t := range uptimerobot.MonitorSubTypes {
+		validSubTypes = append(validSubTypes, t)
+	}
+
+	var validHTTPAuthTypes []string
+	for _, t := range uptimerobot.HTTPAuthTypes {
+		validHTTPAuthTypes = append(validHTTPAuthTypes, t)
+	}
+
+	var validHTTPMethods []string
+	for _, m := range uptimerobot.HTTPMethods {
+		validHTTPMethods = append(validHTTPMethods, m)
+	}
+
+	var validPostTypes []string
+	for _, t := range uptimerobot.PostTypes {
+		validPostTypes = append(validPostTypes, t)
+	}
+
+	var validPostContentTypes []string
+	for _, t := range uptimerobot.PostContentTypes {
+		validPostContentTypes = append(validPostContentTypes, t)
+	}
+
+	var validKeywordTypes []string
+	for _, t := range uptimerobot.KeywordTypes {
+		validKeywordTypes = append(validKeywordTypes, t)
+	}
+
+	var validKeywordCaseTypes []string
+	for _, t := range uptimerobot.KeywordCaseTypes {
+		validKeywordCaseTypes = append(validKeywordCaseTypes, t)
+	}
+
+	statusCodeValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Manages a monitor.",
 		Attributes: map[string]schema.Attribute{
@@ -101,26 +186,199 @@ func (r *monitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 			},
 			"url": schema.StringAttribute{
-				Description: "URL to monitor",
-				Required:    true,
+				Description: "URL to monitor, required for all monitor types except heartbeat",
+				Optional:    true,
+			},
+			"heartbeat_url": schema.StringAttribute{
+				Description: "URL the heartbeat monitor expects to be requested at, only set for heartbeat monitors",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the monitor",
+				Description: "Type of the monitor, changing it replaces the monitor",
 				Required:    true,
 				Validators:  []validator.String{stringvalidator.OneOf(validMonitorTypes...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"interval": schema.Int64Attribute{
 				Description: "Monitor check interval (seconds)",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"timeout": schema.Int64Attribute{
 				Description: "Monitor check timeout (seconds)",
 				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.Int64{int64validator.AtMost(60)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sub_type": schema.StringAttribute{
+				Description: "Protocol checked by the port monitor, required for port monitors, changing it replaces " +
+					"the monitor",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(validSubTypes...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port checked by the port monitor, required for the custom sub type",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"http_username": schema.StringAttribute{
+				Description: "Username for HTTP authentication, only valid for HTTP and keyword monitors",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("http_password")),
+				},
+			},
+			"http_password": schema.StringAttribute{
+				Description: "Password for HTTP authentication, only valid for HTTP and keyword monitors",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("http_username")),
+				},
+			},
+			"http_auth_type": schema.StringAttribute{
+				Description: "HTTP authentication scheme, defaults to basic if HTTP authentication is configured",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validHTTPAuthTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("http_username")),
+				},
+			},
+			"http_method": schema.StringAttribute{
+				Description: "HTTP method used for the check, defaults to HEAD for HTTP monitors and GET for keyword " +
+					"monitors, only valid for HTTP and keyword monitors",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{stringvalidator.OneOf(validHTTPMethods...)},
+			},
+			"post_type": schema.StringAttribute{
+				Description: "Format of the request body, only valid for HTTP methods sending a body",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validPostTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("post_value")),
+				},
+			},
+			"post_content_type": schema.StringAttribute{
+				Description: "Content type of the request body, only valid for HTTP methods sending a body",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validPostContentTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("post_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_value": schema.StringAttribute{
+				Description: "Request body, must be a JSON object of keys and values for the key-value post type",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("post_type")),
+				},
+			},
+			"custom_http_headers": schema.MapAttribute{
+				Description: "Custom HTTP headers sent with the request, only valid for HTTP and keyword monitors",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_custom_http_headers": schema.MapAttribute{
+				Description: "Custom HTTP headers sent with the request whose values are hidden from the plan output, " +
+					"such as authorization tokens, only valid for HTTP and keyword monitors, all headers of imported " +
+					"monitors are read into this attribute",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"custom_http_statuses": schema.SingleNestedAttribute{
+				Description: "HTTP status codes overriding whether the monitor is considered up or down, only valid " +
+					"for HTTP and keyword monitors",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"up": schema.SetAttribute{
+						Description: "HTTP status codes for which the monitor is up",
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Validators:  statusCodeValidators,
+					},
+					"down": schema.SetAttribute{
+						Description: "HTTP status codes for which the monitor is down",
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Validators:  statusCodeValidators,
+					},
+				},
+			},
+			"maintenance_window_ids": schema.SetAttribute{
+				Description: "IDs of the maintenance windows during which the checks of the monitor are paused",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"paused": schema.BoolAttribute{
+				Description: "Whether the checks of the monitor are paused",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ignore_ssl_errors": schema.BoolAttribute{
+				Description: "Whether SSL certificate errors are ignored, only valid for HTTP and keyword monitors",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ssl_expiration_reminder": schema.BoolAttribute{
+				Description: "Whether reminders are sent before the SSL certificate expires, only valid for HTTP and " +
+					"keyword monitors",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"disable_domain_expire_notifications": schema.BoolAttribute{
+				Description: "Whether notifications about the expiry of the domain are disabled, only valid for HTTP " +
+					"and keyword monitors",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"keyword_type": schema.StringAttribute{
+				Description: "Whether the keyword monitor alerts if the keyword exists or does not exist, " +
+					"required for keyword monitors",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf(validKeywordTypes...)},
+			},
+			"keyword_value": schema.StringAttribute{
+				Description: "Keyword to look for in the response, required for keyword monitors",
+				Optional:    true,
+			},
+			"keyword_case_type": schema.StringAttribute{
+				Description: "Whether the keyword is matched case sensitively, defaults to case-insensitive, only valid " +
+					"for keyword monitors",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{stringvalidator.OneOf(validKeywordCaseTypes...)},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"alert_contact": schema.ListNestedBlock{
+				Description: "Alert contacts notified about the monitor, should not be combined with " +
+					"uptimerobot_monitor_alert_contact resources for the same monitor, not populated on import",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -128,11 +386,13 @@ func (r *monitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Required:    true,
 						},
 						"threshold": schema.Int64Attribute{
+							Optional:    true,
 							Computed:    true,
 							Description: "Threshold for alerting (minutes)",
 							Default:     int64default.StaticInt64(0),
 						},
 						"recurrence": schema.Int64Attribute{
+							Optional:    true,
 							Computed:    true,
 							Description: "Repetition interval for alerts (minutes)",
 							Default:     int64default.StaticInt64(0),
@@ -150,6 +410,15 @@ func monitorFromPlan(plan monitorResourceModel) (uptimerobot.Monitor, error) {
 		URL:          plan.URL.ValueString(),
 		Interval:     plan.Interval.ValueInt64(),
 		Timeout:      plan.Timeout.ValueInt64(),
+		Status:       uptimerobot.MonitorStatusActive,
+
+		IgnoreSSLErrors:                  plan.IgnoreSSLErrors.ValueBool(),
+		SSLExpirationReminder:            plan.SSLExpirationReminder.ValueBool(),
+		DisableDomainExpireNotifications: plan.DisableDomainExpireNotifications.ValueBool(),
+	}
+
+	if plan.Paused.ValueBool() {
+		monitor.Status = uptimerobot.MonitorStatusPaused
 	}
 
 	var monitorACs []uptimerobot.MonitorAlertContact
@@ -163,15 +432,712 @@ func monitorFromPlan(plan monitorResourceModel) (uptimerobot.Monitor, error) {
 	}
 	monitor.AlertContacts = monitorACs
 
+	for _, id := range stringSetFromValue(plan.MaintenanceWindowIDs) {
+		windowID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return monitor, fmt.Errorf("invalid maintenance window ID %q: %w", id, err)
+		}
+		monitor.MaintenanceWindowIDs = append(monitor.MaintenanceWindowIDs, windowID)
+	}
+
 	intType, err := uptimerobot.MonitorTypeToInt(plan.Type.ValueString())
 	if err != nil {
 		return monitor, err
 	}
-
 	monitor.Type = intType
+
+	if plan.Type.ValueString() == portMonitorType {
+		monitor.SubType, err = uptimerobot.MonitorSubTypeToDesignator(plan.SubType.ValueString())
+		if err != nil {
+			return monitor, err
+		}
+		monitor.Port = plan.Port.ValueInt64()
+	}
+
+	if !plan.HTTPUsername.IsNull() {
+		monitor.HTTPUsername = plan.HTTPUsername.ValueString()
+		monitor.HTTPPassword = plan.HTTPPassword.ValueString()
+
+		monitor.HTTPAuthType = uptimerobot.HTTPAuthBasic
+		if !plan.HTTPAuthType.IsNull() && !plan.HTTPAuthType.IsUnknown() {
+			monitor.HTTPAuthType, err = uptimerobot.HTTPAuthTypeToDesignator(plan.HTTPAuthType.ValueString())
+			if err != nil {
+				return monitor, err
+			}
+		}
+	}
+
+	if isHTTPMonitor(plan.Type.ValueString()) {
+		// The default method is sent explicitly so that removing http_method resets the method of the monitor.
+		method := defaultHTTPMethod(plan.Type.ValueString())
+		if !plan.HTTPMethod.IsNull() && !plan.HTTPMethod.IsUnknown() {
+			method = plan.HTTPMethod.ValueString()
+		}
+
+		monitor.HTTPMethod, err = uptimerobot.HTTPMethodToDesignator(method)
+		if err != nil {
+			return monitor, err
+		}
+	}
+
+	if !plan.PostType.IsNull() {
+		monitor.PostType, err = uptimerobot.PostTypeToDesignator(plan.PostType.ValueString())
+		if err != nil {
+			return monitor, err
+		}
+		monitor.PostValue = plan.PostValue.ValueString()
+
+		if !plan.PostContentType.IsNull() && !plan.PostContentType.IsUnknown() {
+			monitor.PostContentType, err = uptimerobot.PostContentTypeToDesignator(plan.PostContentType.ValueString())
+			if err != nil {
+				return monitor, err
+			}
+		}
+	}
+
+	headers := stringMapFromValue(plan.CustomHTTPHeaders)
+	for name, value := range stringMapFromValue(plan.SensitiveCustomHTTPHeaders) {
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers[name] = value
+	}
+	monitor.CustomHTTPHeaders = headers
+
+	if plan.CustomHTTPStatuses != nil {
+		monitor.CustomHTTPStatuses = uptimerobot.CustomHTTPStatuses{
+			Up:   int64SetFromValue(plan.CustomHTTPStatuses.Up),
+			Down: int64SetFromValue(plan.CustomHTTPStatuses.Down),
+		}
+	}
+
+	if plan.Type.ValueString() == keywordMonitorType {
+		monitor.KeywordType, err = uptimerobot.KeywordTypeToDesignator(plan.KeywordType.ValueString())
+		if err != nil {
+			return monitor, err
+		}
+		monitor.KeywordValue = plan.KeywordValue.ValueString()
+
+		monitor.KeywordCaseType = uptimerobot.KeywordCaseInsensitive
+		if !plan.KeywordCaseType.IsNull() && !plan.KeywordCaseType.IsUnknown() {
+			monitor.KeywordCaseType, err = uptimerobot.KeywordCaseTypeToDesignator(plan.KeywordCaseType.ValueString())
+			if err != nil {
+				return monitor, err
+			}
+		}
+	}
+
 	return monitor, nil
 }
 
+func monitorAlertContactFromAPI(contact uptimerobot.MonitorAlertContact) monitorAlertContact {
+	return monitorAlertContact{
+		ID:         types.StringValue(contact.ID),
+		Threshold:  types.Int64Value(contact.Threshold),
+		Recurrence: types.Int64Value(contact.Recurrence),
+	}
+}
+
+// mergeMonitorAlertContacts maps the alert contacts of a monitor to the model, keeping the order of the contacts
+// already known so that reordering by the API does not show up as a change.
+func mergeMonitorAlertContacts(known []monitorAlertContact, contacts []uptimerobot.MonitorAlertContact) []monitorAlertContact {
+	if len(known) == 0 && len(contacts) == 0 {
+		return known
+	}
+
+	byID := make(map[string]uptimerobot.MonitorAlertContact)
+	for _, contact := range contacts {
+		byID[contact.ID] = contact
+	}
+
+	merged := make([]monitorAlertContact, 0, len(contacts))
+	for _, k := range known {
+		contact, ok := byID[k.ID.ValueString()]
+		if !ok {
+			continue
+		}
+
+		merged = append(merged, monitorAlertContactFromAPI(contact))
+		delete(byID, contact.ID)
+	}
+
+	for _, contact := range contacts {
+		if _, ok := byID[contact.ID]; ok {
+			merged = append(merged, monitorAlertContactFromAPI(contact))
+		}
+	}
+
+	return merged
+}
+
+func updateKeywordFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	if model.Type.ValueString() != keywordMonitorType {
+		model.KeywordType = types.StringNull()
+		model.KeywordValue = types.StringNull()
+		model.KeywordCaseType = types.StringNull()
+		return nil
+	}
+
+	keywordType, err := uptimerobot.KeywordTypeToString(monitor.KeywordType)
+	if err != nil {
+		return err
+	}
+	model.KeywordType = types.StringValue(keywordType)
+	model.KeywordValue = types.StringValue(monitor.KeywordValue)
+
+	keywordCaseType, err := uptimerobot.KeywordCaseTypeToString(monitor.KeywordCaseType)
+	if err != nil {
+		return err
+	}
+	model.KeywordCaseType = types.StringValue(keywordCaseType)
+
+	return nil
+}
+
+func isHTTPMonitor(monitorType string) bool {
+	return monitorType == httpMonitorType || monitorType == keywordMonitorType
+}
+
+// defaultHTTPMethod returns the HTTP method used by monitors of the given type if http_method is not set, keyword
+// monitors need the response body to look for the keyword.
+func defaultHTTPMethod(monitorType string) string {
+	if monitorType == keywordMonitorType {
+		return http.MethodGet
+	}
+
+	return http.MethodHead
+}
+
+func updateHTTPAuthFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	if !isHTTPMonitor(model.Type.ValueString()) || monitor.HTTPUsername == "" {
+		model.HTTPUsername = types.StringNull()
+		model.HTTPPassword = types.StringNull()
+		model.HTTPAuthType = types.StringNull()
+		return nil
+	}
+
+	model.HTTPUsername = types.StringValue(monitor.HTTPUsername)
+	// The API may mask the password, in which case the known value is kept to avoid a perpetual diff.
+	if !uptimerobot.IsMaskedSecret(monitor.HTTPPassword) {
+		model.HTTPPassword = types.StringValue(monitor.HTTPPassword)
+	}
+
+	authType, err := uptimerobot.HTTPAuthTypeToString(monitor.HTTPAuthType)
+	if err != nil {
+		return err
+	}
+	model.HTTPAuthType = types.StringValue(authType)
+
+	return nil
+}
+
+// sendsBody reports whether requests using the given HTTP method can have a body.
+func sendsBody(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// jsonEqual reports whether both values are valid JSON documents with the same content.
+func jsonEqual(a, b string) bool {
+	var aValue, bValue any
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func updateRequestFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	model.HTTPMethod = types.StringNull()
+	if isHTTPMonitor(model.Type.ValueString()) && monitor.HTTPMethod != 0 {
+		method, err := uptimerobot.HTTPMethodToString(monitor.HTTPMethod)
+		if err != nil {
+			return err
+		}
+		model.HTTPMethod = types.StringValue(method)
+	}
+
+	if !sendsBody(model.HTTPMethod.ValueString()) || monitor.PostType == 0 {
+		model.PostType = types.StringNull()
+		model.PostContentType = types.StringNull()
+		model.PostValue = types.StringNull()
+		return nil
+	}
+
+	postType, err := uptimerobot.PostTypeToString(monitor.PostType)
+	if err != nil {
+		return err
+	}
+	model.PostType = types.StringValue(postType)
+
+	contentType, err := uptimerobot.PostContentTypeToString(monitor.PostContentType)
+	if err != nil {
+		return err
+	}
+	model.PostContentType = types.StringValue(contentType)
+
+	// Keep the configured formatting of JSON post values unless the content changed.
+	if !jsonEqual(model.PostValue.ValueString(), monitor.PostValue) {
+		model.PostValue = types.StringValue(monitor.PostValue)
+	}
+
+	return nil
+}
+
+// stringMapFromValue returns the known elements of a map of strings, or nil if the map is null or unknown.
+func stringMapFromValue(value types.Map) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	out := make(map[string]string)
+	for k, v := range value.Elements() {
+		if str, ok := v.(types.String); ok && !str.IsUnknown() {
+			out[k] = str.ValueString()
+		}
+	}
+	return out
+}
+
+// stringMapValue converts a map of strings to a map value, which is null when the map is empty.
+func stringMapValue(m map[string]string) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// updateCustomHTTPHeadersFromMonitor splits the headers of the monitor into plain and sensitive ones. Only headers
+// already known to be plain are kept in the plain map, so that headers of imported monitors or headers added outside
+// of Terraform, which may hold credentials, are never shown in the plan output.
+func updateCustomHTTPHeadersFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) {
+	plain := stringMapFromValue(model.CustomHTTPHeaders)
+	if !isHTTPMonitor(model.Type.ValueString()) {
+		model.CustomHTTPHeaders = types.MapNull(types.StringType)
+		model.SensitiveCustomHTTPHeaders = types.MapNull(types.StringType)
+		return
+	}
+
+	plainHeaders := make(map[string]string)
+	sensitiveHeaders := make(map[string]string)
+	for name, value := range monitor.CustomHTTPHeaders {
+		if _, ok := plain[name]; ok {
+			plainHeaders[name] = value
+		} else {
+			sensitiveHeaders[name] = value
+		}
+	}
+
+	model.CustomHTTPHeaders = stringMapValue(plainHeaders)
+	model.SensitiveCustomHTTPHeaders = stringMapValue(sensitiveHeaders)
+}
+
+// stringSetFromValue returns the known elements of a set of strings, or nil if the set is null or unknown.
+func stringSetFromValue(value types.Set) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var out []string
+	for _, v := range value.Elements() {
+		if str, ok := v.(types.String); ok && !str.IsUnknown() {
+			out = append(out, str.ValueString())
+		}
+	}
+	return out
+}
+
+// stringSetValue converts strings to a set value, which is null when there are no strings.
+func stringSetValue(strs []string) types.Set {
+	if len(strs) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(strs))
+	for _, str := range strs {
+		elements = append(elements, types.StringValue(str))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// int64SetFromValue returns the known elements of a set of numbers, or nil if the set is null or unknown.
+func int64SetFromValue(value types.Set) []int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var out []int64
+	for _, v := range value.Elements() {
+		if number, ok := v.(types.Int64); ok && !number.IsUnknown() {
+			out = append(out, number.ValueInt64())
+		}
+	}
+	return out
+}
+
+// int64SetValue converts numbers to a set value, which is null when there are no numbers.
+func int64SetValue(numbers []int64) types.Set {
+	if len(numbers) == 0 {
+		return types.SetNull(types.Int64Type)
+	}
+
+	elements := make([]attr.Value, 0, len(numbers))
+	for _, number := range numbers {
+		elements = append(elements, types.Int64Value(number))
+	}
+	return types.SetValueMust(types.Int64Type, elements)
+}
+
+func updateCustomHTTPStatusesFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) {
+	if !isHTTPMonitor(model.Type.ValueString()) || monitor.CustomHTTPStatuses.IsEmpty() {
+		model.CustomHTTPStatuses = nil
+		return
+	}
+
+	model.CustomHTTPStatuses = &monitorCustomHTTPStatuses{
+		Up:   int64SetValue(monitor.CustomHTTPStatuses.Up),
+		Down: int64SetValue(monitor.CustomHTTPStatuses.Down),
+	}
+}
+
+func updatePortFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	model.SubType = types.StringNull()
+	model.Port = types.Int64Null()
+	if model.Type.ValueString() != portMonitorType {
+		return nil
+	}
+
+	subType, err := uptimerobot.MonitorSubTypeToString(monitor.SubType)
+	if err != nil {
+		return err
+	}
+	model.SubType = types.StringValue(subType)
+
+	if subType == customPortSubType {
+		model.Port = types.Int64Value(monitor.Port)
+	}
+
+	return nil
+}
+
+func updateFromMonitor(model *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	monitorType, err := uptimerobot.MonitorTypeToStr(monitor.Type)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringValue(strconv.FormatInt(monitor.ID, 10))
+	model.Type = types.StringValue(monitorType)
+	model.FriendlyName = types.StringValue(monitor.FriendlyName)
+	model.Interval = types.Int64Value(monitor.Interval)
+	model.Timeout = types.Int64Value(monitor.Timeout)
+	model.URL = types.StringValue(monitor.URL)
+	model.HeartbeatURL = types.StringNull()
+	if monitorType == heartbeatMonitorType {
+		model.URL = types.StringNull()
+		model.HeartbeatURL = types.StringValue(monitor.HeartbeatURL())
+	}
+	model.Paused = types.BoolValue(monitor.IsPaused())
+	model.IgnoreSSLErrors = types.BoolValue(monitor.IgnoreSSLErrors)
+	model.SSLExpirationReminder = types.BoolValue(monitor.SSLExpirationReminder)
+	model.DisableDomainExpireNotifications = types.BoolValue(monitor.DisableDomainExpireNotifications)
+	// Alert contacts of monitors without alert_contact blocks, including imported monitors, are left to
+	// uptimerobot_monitor_alert_contact resources, so that contacts attached by them do not show up as changes.
+	if len(model.AlertContacts) > 0 {
+		model.AlertContacts = mergeMonitorAlertContacts(model.AlertContacts, monitor.AlertContacts)
+	}
+
+	var windowIDs []string
+	for _, id := range monitor.MaintenanceWindowIDs {
+		windowIDs = append(windowIDs, strconv.FormatInt(id, 10))
+	}
+	model.MaintenanceWindowIDs = stringSetValue(windowIDs)
+
+	err = updateKeywordFromMonitor(model, monitor)
+	if err != nil {
+		return err
+	}
+
+	err = updateHTTPAuthFromMonitor(model, monitor)
+	if err != nil {
+		return err
+	}
+
+	err = updateRequestFromMonitor(model, monitor)
+	if err != nil {
+		return err
+	}
+
+	updateCustomHTTPHeadersFromMonitor(model, monitor)
+	updateCustomHTTPStatusesFromMonitor(model, monitor)
+
+	return updatePortFromMonitor(model, monitor)
+}
+
+// setComputedFromMonitor sets the values decided by the API for attributes left unknown in the plan.
+func setComputedFromMonitor(plan *monitorResourceModel, monitor uptimerobot.Monitor) error {
+	plan.ID = types.StringValue(strconv.FormatInt(monitor.ID, 10))
+	if plan.Interval.IsUnknown() {
+		plan.Interval = types.Int64Value(monitor.Interval)
+	}
+	if plan.Timeout.IsUnknown() {
+		plan.Timeout = types.Int64Value(monitor.Timeout)
+	}
+	if plan.HeartbeatURL.IsUnknown() {
+		plan.HeartbeatURL = types.StringNull()
+		if plan.Type.ValueString() == heartbeatMonitorType {
+			plan.HeartbeatURL = types.StringValue(monitor.HeartbeatURL())
+		}
+	}
+
+	if plan.HTTPAuthType.IsUnknown() {
+		plan.HTTPAuthType = types.StringNull()
+		if !plan.HTTPUsername.IsNull() {
+			authType, err := uptimerobot.HTTPAuthTypeToString(monitor.HTTPAuthType)
+			if err != nil {
+				return err
+			}
+			plan.HTTPAuthType = types.StringValue(authType)
+		}
+	}
+
+	if plan.HTTPMethod.IsUnknown() {
+		plan.HTTPMethod = types.StringNull()
+		if isHTTPMonitor(plan.Type.ValueString()) && monitor.HTTPMethod != 0 {
+			method, err := uptimerobot.HTTPMethodToString(monitor.HTTPMethod)
+			if err != nil {
+				return err
+			}
+			plan.HTTPMethod = types.StringValue(method)
+		}
+	}
+
+	if plan.PostContentType.IsUnknown() {
+		plan.PostContentType = types.StringNull()
+		if !plan.PostType.IsNull() {
+			contentType, err := uptimerobot.PostContentTypeToString(monitor.PostContentType)
+			if err != nil {
+				return err
+			}
+			plan.PostContentType = types.StringValue(contentType)
+		}
+	}
+
+	if plan.KeywordCaseType.IsUnknown() {
+		plan.KeywordCaseType = types.StringNull()
+		if plan.Type.ValueString() == keywordMonitorType {
+			keywordCaseType, err := uptimerobot.KeywordCaseTypeToString(monitor.KeywordCaseType)
+			if err != nil {
+				return err
+			}
+			plan.KeywordCaseType = types.StringValue(keywordCaseType)
+		}
+	}
+
+	return nil
+}
+
+// requireAttributes adds an error for each of the given attributes missing from the configuration.
+func requireAttributes(resp *resource.ValidateConfigResponse, reason string, attrs map[string]attr.Value) {
+	for _, name := range sortedKeys(attrs) {
+		if attrs[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing monitor attribute",
+				fmt.Sprintf("Attribute %s is required %s.", name, reason))
+		}
+	}
+}
+
+// rejectAttributes adds an error for each of the given attributes set in the configuration.
+func rejectAttributes(resp *resource.ValidateConfigResponse, reason string, attrs map[string]attr.Value) {
+	for _, name := range sortedKeys(attrs) {
+		if !attrs[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid monitor attribute",
+				fmt.Sprintf("Attribute %s can only be set %s.", name, reason))
+		}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func validateCustomHTTPStatuses(resp *resource.ValidateConfigResponse, monitorType string, statuses monitorCustomHTTPStatuses) {
+	statusesPath := path.Root("custom_http_statuses")
+	if !isHTTPMonitor(monitorType) {
+		resp.Diagnostics.AddAttributeError(statusesPath, "Invalid monitor attribute",
+			"Attribute custom_http_statuses can only be set for HTTP and keyword monitors.")
+		return
+	}
+
+	if statuses.Up.IsNull() && statuses.Down.IsNull() {
+		resp.Diagnostics.AddAttributeError(statusesPath, "Missing monitor attribute",
+			"Attribute custom_http_statuses requires at least one of up or down.")
+		return
+	}
+
+	up := make(map[int64]bool)
+	for _, code := range int64SetFromValue(statuses.Up) {
+		up[code] = true
+	}
+	for _, code := range int64SetFromValue(statuses.Down) {
+		if up[code] {
+			resp.Diagnostics.AddAttributeError(statusesPath, "Invalid monitor attribute",
+				fmt.Sprintf("HTTP status code %d cannot be both up and down.", code))
+		}
+	}
+}
+
+func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config monitorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	urlAttrs := map[string]attr.Value{"url": config.URL}
+	if config.Type.ValueString() == heartbeatMonitorType {
+		rejectAttributes(resp, "for monitor types other than heartbeat", urlAttrs)
+	} else {
+		requireAttributes(resp, "for all monitor types except heartbeat", urlAttrs)
+	}
+
+	keywordAttrs := map[string]attr.Value{
+		"keyword_type":  config.KeywordType,
+		"keyword_value": config.KeywordValue,
+	}
+	if config.Type.ValueString() == keywordMonitorType {
+		requireAttributes(resp, "for keyword monitors", keywordAttrs)
+	} else {
+		keywordAttrs["keyword_case_type"] = config.KeywordCaseType
+		rejectAttributes(resp, "for keyword monitors", keywordAttrs)
+	}
+
+	if !isHTTPMonitor(config.Type.ValueString()) {
+		rejectAttributes(resp, "for HTTP and keyword monitors", map[string]attr.Value{
+			"http_username":                       config.HTTPUsername,
+			"http_password":                       config.HTTPPassword,
+			"http_auth_type":                      config.HTTPAuthType,
+			"http_method":                         config.HTTPMethod,
+			"ignore_ssl_errors":                   config.IgnoreSSLErrors,
+			"ssl_expiration_reminder":             config.SSLExpirationReminder,
+			"disable_domain_expire_notifications": config.DisableDomainExpireNotifications,
+			"custom_http_headers":                 config.CustomHTTPHeaders,
+			"sensitive_custom_http_headers":       config.SensitiveCustomHTTPHeaders,
+		})
+	}
+
+	sensitiveHeaders := stringMapFromValue(config.SensitiveCustomHTTPHeaders)
+	for _, name := range sortedKeys(stringMapFromValue(config.CustomHTTPHeaders)) {
+		if _, ok := sensitiveHeaders[name]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("custom_http_headers").AtMapKey(name),
+				"Invalid monitor attribute",
+				fmt.Sprintf("Header %s cannot be set in both custom_http_headers and sensitive_custom_http_headers.", name))
+		}
+	}
+
+	if config.CustomHTTPStatuses != nil {
+		validateCustomHTTPStatuses(resp, config.Type.ValueString(), *config.CustomHTTPStatuses)
+	}
+
+	if !config.HTTPMethod.IsUnknown() && !sendsBody(config.HTTPMethod.ValueString()) {
+		rejectAttributes(resp, "for the POST, PUT, PATCH and DELETE HTTP methods", map[string]attr.Value{
+			"post_type":         config.PostType,
+			"post_content_type": config.PostContentType,
+			"post_value":        config.PostValue,
+		})
+	}
+
+	if config.PostType.ValueString() == uptimerobot.PostTypes[uptimerobot.PostTypeKeyValue] && !config.PostValue.IsUnknown() {
+		var object map[string]any
+		if json.Unmarshal([]byte(config.PostValue.ValueString()), &object) != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("post_value"), "Invalid monitor attribute",
+				"Attribute post_value must be a JSON object for the key-value post type.")
+		}
+	}
+
+	if config.Type.ValueString() == portMonitorType {
+		requireAttributes(resp, "for port monitors", map[string]attr.Value{"sub_type": config.SubType})
+	} else {
+		rejectAttributes(resp, "for port monitors", map[string]attr.Value{"sub_type": config.SubType})
+	}
+
+	if config.SubType.IsUnknown() {
+		return
+	}
+
+	portAttrs := map[string]attr.Value{"port": config.Port}
+	if config.SubType.ValueString() == customPortSubType {
+		requireAttributes(resp, "for the custom port sub type", portAttrs)
+	} else {
+		rejectAttributes(resp, "for the custom port sub type", portAttrs)
+	}
+}
+
+// ModifyPlan plans the defaults of computed attributes which are not configured. Terraform carries the prior state
+// over for unconfigured computed attributes, so without this removing them from the configuration would keep the
+// previous value instead of resetting the monitor to the default.
+func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsUnknown() {
+		return
+	}
+
+	httpMethod := types.StringNull()
+	if isHTTPMonitor(monitorType.ValueString()) {
+		httpMethod = types.StringValue(defaultHTTPMethod(monitorType.ValueString()))
+	}
+	planDefault(ctx, req, resp, "http_method", httpMethod)
+
+	var httpUsername types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http_username"), &httpUsername)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch {
+	case httpUsername.IsUnknown():
+	case httpUsername.IsNull():
+		planDefault(ctx, req, resp, "http_auth_type", types.StringNull())
+	default:
+		planDefault(ctx, req, resp, "http_auth_type", types.StringValue(uptimerobot.HTTPAuthTypes[uptimerobot.HTTPAuthBasic]))
+	}
+
+	keywordCaseType := types.StringNull()
+	if monitorType.ValueString() == keywordMonitorType {
+		keywordCaseType = types.StringValue(uptimerobot.KeywordCaseTypes[uptimerobot.KeywordCaseInsensitive])
+	}
+	planDefault(ctx, req, resp, "keyword_case_type", keywordCaseType)
+}
+
+// planDefault plans the given default for the attribute if it is not configured.
+func planDefault(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, name string, value types.String) {
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+}
+
 func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan monitorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -185,11 +1151,11 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating monitor",
-			"Could not create monitor, error determining monitor type: "+err.Error())
+			"Could not create monitor from plan: "+err.Error())
 		return
 	}
 
-	monitor, err = r.client.CreateMonitor(monitor)
+	created, err := r.client.CreateMonitor(ctx, monitor)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating monitor",
@@ -197,8 +1163,40 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	planID := strconv.Itoa(int(monitor.ID))
-	plan.ID = types.StringValue(planID)
+	// Record the monitor before the follow-up calls, so that it is tracked as tainted rather than left unmanaged if
+	// they fail.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(created.ID, 10))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Monitors are always created active, pausing them requires editing the monitor.
+	if monitor.IsPaused() {
+		monitor.ID = created.ID
+		_, err = r.client.UpdateMonitor(ctx, monitor)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating monitor",
+				fmt.Sprintf("Could not pause monitor %d after creation, unexpected error: %v", created.ID, err))
+			return
+		}
+	}
+
+	monitor, err = r.client.GetMonitor(ctx, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating monitor",
+			"Could not read monitor after creation, unexpected error: "+err.Error())
+		return
+	}
+
+	err = setComputedFromMonitor(&plan, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating monitor",
+			"Could not map created monitor to state: "+err.Error())
+		return
+	}
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	diags = resp.State.Set(ctx, plan)
@@ -222,7 +1220,12 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	monitorId := int64(id)
 
-	monitor, err := r.client.GetMonitor(monitorId)
+	monitor, err := r.client.GetMonitor(ctx, monitorId)
+	if uptimerobot.IsNotFound(err) {
+		tflog.Warn(ctx, "UptimeRobot monitor not found, removing from state", map[string]any{"id": monitorId})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading UptimeRobot monitor",
@@ -230,20 +1233,42 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	monitorType, err := uptimerobot.MonitorTypeToStr(monitor.Type)
+	err = updateFromMonitor(&state, monitor)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading UptimeRobot monitor",
-			fmt.Sprintf("Could not read UptimeRobot monitor with ID %d, error determining monitoro type: %v",
-				id, err))
+			fmt.Sprintf("Could not map UptimeRobot monitor with ID %d to state: %v", id, err))
 		return
 	}
 
-	state.Type = types.StringValue(monitorType)
-	state.FriendlyName = types.StringValue(monitor.FriendlyName)
-	state.Interval = types.Int64Value(monitor.Interval)
-	state.Timeout = types.Int64Value(monitor.Timeout)
-	state.URL = types.StringValue(monitor.URL)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// detachAlertContacts removes the given alert contacts from the monitor, keeping contacts attached by
+// uptimerobot_monitor_alert_contact resources.
+func (r *monitorResource) detachAlertContacts(ctx context.Context, id int64, detached []monitorAlertContact) error {
+	unlock := lockMonitor(id)
+	defer unlock()
+
+	monitor, err := r.client.GetMonitor(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	detachedIDs := make(map[string]bool)
+	for _, contact := range detached {
+		detachedIDs[contact.ID.ValueString()] = true
+	}
+
+	var remaining []uptimerobot.MonitorAlertContact
+	for _, contact := range monitor.AlertContacts {
+		if !detachedIDs[contact.ID] {
+			remaining = append(remaining, contact)
+		}
+	}
+
+	return r.client.UpdateMonitorAlertContacts(ctx, id, remaining)
 }
 
 func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -271,11 +1296,38 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	monitor.ID = int64(monitorID)
 
-	monitor, err = r.client.UpdateMonitor(monitor)
+	var state monitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// editMonitor keeps the alert contacts if none are sent, so removing the last alert_contact block has to
+	// detach the contacts explicitly.
+	if len(state.AlertContacts) > 0 && len(plan.AlertContacts) == 0 {
+		err = r.detachAlertContacts(ctx, monitor.ID, state.AlertContacts)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating monitor",
+				fmt.Sprintf("Could not remove alert contacts of monitor %v", err))
+			return
+		}
+	}
+
+	monitor, err = r.client.UpdateMonitor(ctx, monitor)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating monitor",
 			fmt.Sprintf("Could not update monitor %v", err))
+		return
+	}
+
+	err = setComputedFromMonitor(&plan, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating monitor",
+			fmt.Sprintf("Could not map updated monitor to state: %v", err))
+		return
 	}
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -300,7 +1352,7 @@ func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err = r.client.DeleteMonitor(int64(monitorID))
+	err = r.client.DeleteMonitor(ctx, int64(monitorID))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting monitor",
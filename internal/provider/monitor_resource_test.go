@@ -1,10 +1,103 @@
 package provider
 
 import (
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"context"
+	"reflect"
+	"regexp"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
 )
 
+// planMonitor runs ModifyPlan for the given configuration and proposed plan, in which Terraform carries over the
+// prior state of unconfigured computed attributes, and returns the planned value of the attribute.
+func planMonitor(t *testing.T, config, proposed map[string]string, attribute string) types.String {
+	ctx := context.Background()
+	r := &monitorResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	build := func(values map[string]string) tftypes.Value {
+		attrs := make(map[string]tftypes.Value)
+		for name, typ := range objectType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(typ, nil)
+			if value, ok := values[name]; ok {
+				attrs[name] = tftypes.NewValue(tftypes.String, value)
+			}
+		}
+		return tftypes.NewValue(objectType, attrs)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: build(proposed)}
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: build(config)},
+		Plan:   plan,
+	}
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var planned types.String
+	if diags := resp.Plan.GetAttribute(ctx, path.Root(attribute), &planned); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return planned
+}
+
+func TestMonitorModifyPlanDefaults(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    map[string]string
+		prior     map[string]string
+		attribute string
+		expected  types.String
+	}{
+		{"removed method of http monitor", map[string]string{"type": "http"},
+			map[string]string{"http_method": "POST"}, "http_method", types.StringValue("HEAD")},
+		{"removed method of keyword monitor", map[string]string{"type": "keyword"},
+			map[string]string{"http_method": "POST"}, "http_method", types.StringValue("GET")},
+		{"configured method", map[string]string{"type": "http", "http_method": "POST"},
+			nil, "http_method", types.StringValue("POST")},
+		{"port monitor method", map[string]string{"type": "port"}, nil, "http_method", types.StringNull()},
+		{"removed auth type", map[string]string{"type": "http", "http_username": "user", "http_password": "secret"},
+			map[string]string{"http_auth_type": "digest"}, "http_auth_type", types.StringValue("basic")},
+		{"configured auth type", map[string]string{"type": "http", "http_username": "user", "http_password": "secret",
+			"http_auth_type": "digest"}, nil, "http_auth_type", types.StringValue("digest")},
+		{"removed auth", map[string]string{"type": "http"},
+			map[string]string{"http_auth_type": "digest"}, "http_auth_type", types.StringNull()},
+		{"removed keyword case type", map[string]string{"type": "keyword"},
+			map[string]string{"keyword_case_type": "case-sensitive"}, "keyword_case_type", types.StringValue("case-insensitive")},
+		{"http monitor keyword case type", map[string]string{"type": "http"}, nil, "keyword_case_type", types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proposed := make(map[string]string)
+			for name, value := range tt.prior {
+				proposed[name] = value
+			}
+			for name, value := range tt.config {
+				proposed[name] = value
+			}
+
+			if planned := planMonitor(t, tt.config, proposed, tt.attribute); !planned.Equal(tt.expected) {
+				t.Errorf("expected %s to be planned as %s, got %s", tt.attribute, tt.expected, planned)
+			}
+		})
+	}
+}
+
 func TestAccMonitorResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -56,3 +149,623 @@ resource "uptimerobot_monitor" "test" {
 		},
 	})
 }
+
+func TestAccKeywordMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "keyword"
+  keyword_type = "not exists"
+  keyword_value = "error"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "keyword"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_type", "not exists"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_value", "error"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_case_type", "case-insensitive"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "keyword"
+  keyword_type = "exists"
+  keyword_value = "OK"
+  keyword_case_type = "case-sensitive"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_type", "exists"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_value", "OK"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "keyword_case_type", "case-sensitive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "example.com"
+  type = "port"
+  sub_type = "custom"
+  port = 8080
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "port"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "sub_type", "custom"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "port", "8080"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "example.com"
+  type = "port"
+  sub_type = "https"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "sub_type", "https"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "port"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHTTPAuthMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  http_username = "user"
+  http_password = "secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_username", "user"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_password", "secret"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_auth_type", "basic"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "http_password"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  http_username = "user"
+  http_password = "other-secret"
+  http_auth_type = "digest"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_password", "other-secret"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_auth_type", "digest"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  http_username = "user"
+  http_password = "other-secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_auth_type", "basic"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "http_username"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "http_password"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "http_auth_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+  http_method = "POST"
+  post_type = "key-value"
+  post_content_type = "application/json"
+  post_value = jsonencode({ check = "deep" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_method", "POST"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_type", "key-value"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_content_type", "application/json"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "post_value", `{"check":"deep"}`),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+  http_method = "POST"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_method", "POST"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "post_type"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "post_content_type"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "post_value"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_method", "HEAD"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+  http_method = "GET"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "http_method", "GET"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "post_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateCustomHTTPHeadersFromMonitor(t *testing.T) {
+	monitor := uptimerobot.Monitor{CustomHTTPHeaders: map[string]string{
+		"Authorization": "Bearer secret",
+		"X-Check":       "terraform",
+	}}
+
+	imported := monitorResourceModel{
+		Type:                       types.StringValue(httpMonitorType),
+		CustomHTTPHeaders:          types.MapNull(types.StringType),
+		SensitiveCustomHTTPHeaders: types.MapNull(types.StringType),
+	}
+	updateCustomHTTPHeadersFromMonitor(&imported, monitor)
+	if !imported.CustomHTTPHeaders.IsNull() || len(imported.SensitiveCustomHTTPHeaders.Elements()) != 2 {
+		t.Errorf("expected all imported headers to be sensitive, got %s and %s", imported.CustomHTTPHeaders,
+			imported.SensitiveCustomHTTPHeaders)
+	}
+
+	known := monitorResourceModel{
+		Type:                       types.StringValue(httpMonitorType),
+		CustomHTTPHeaders:          stringMapValue(map[string]string{"X-Check": "terraform"}),
+		SensitiveCustomHTTPHeaders: types.MapNull(types.StringType),
+	}
+	updateCustomHTTPHeadersFromMonitor(&known, monitor)
+	expectedPlain := map[string]string{"X-Check": "terraform"}
+	expectedSensitive := map[string]string{"Authorization": "Bearer secret"}
+	if !reflect.DeepEqual(stringMapFromValue(known.CustomHTTPHeaders), expectedPlain) ||
+		!reflect.DeepEqual(stringMapFromValue(known.SensitiveCustomHTTPHeaders), expectedSensitive) {
+		t.Errorf("expected only known plain headers to be plain, got %s and %s", known.CustomHTTPHeaders,
+			known.SensitiveCustomHTTPHeaders)
+	}
+}
+
+func TestAccCustomHTTPHeadersMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+  custom_http_headers = {
+    "X-Check" = "terraform"
+  }
+  sensitive_custom_http_headers = {
+    "Authorization" = "Bearer secret"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_headers.%", "1"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_headers.X-Check", "terraform"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "sensitive_custom_http_headers.Authorization", "Bearer secret"),
+				),
+			},
+			{
+				ResourceName:      "uptimerobot_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// All headers are imported as sensitive, since plain ones cannot be told apart from secrets.
+				ImportStateVerifyIgnore: []string{"last_updated", "custom_http_headers", "sensitive_custom_http_headers"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "custom_http_headers"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "sensitive_custom_http_headers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomHTTPStatusesMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+  custom_http_statuses = {
+    up = [200, 401]
+    down = [404]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "custom_http_statuses.up.#", "2"),
+					resource.TestCheckTypeSetElemAttr("uptimerobot_monitor.test", "custom_http_statuses.up.*", "401"),
+					resource.TestCheckTypeSetElemAttr("uptimerobot_monitor.test", "custom_http_statuses.down.*", "404"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com/health"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "custom_http_statuses"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSLMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "https://self-signed.badssl.com"
+  type = "http"
+  ignore_ssl_errors = true
+  ssl_expiration_reminder = true
+  disable_domain_expire_notifications = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "ignore_ssl_errors", "true"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "ssl_expiration_reminder", "true"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "disable_domain_expire_notifications", "true"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "https://self-signed.badssl.com"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "ignore_ssl_errors", "false"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "ssl_expiration_reminder", "false"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "disable_domain_expire_notifications", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHeartbeatMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  type = "heartbeat"
+  interval = 300
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "heartbeat"),
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "url"),
+					resource.TestMatchResourceAttr("uptimerobot_monitor.test", "heartbeat_url",
+						regexp.MustCompile(`^https://heartbeat\.uptimerobot\.com/.+`)),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestAccPausedMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  paused = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "paused", "true"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMonitorResourceTypeChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+`,
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "keyword"
+  keyword_type = "exists"
+  keyword_value = "Example"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("uptimerobot_monitor.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "type", "keyword"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMaintenanceWindowMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test"
+  type = "daily"
+  start_time = "02:00"
+  duration = 30
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  maintenance_window_ids = [uptimerobot_maintenance_window.test.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "maintenance_window_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("uptimerobot_monitor.test", "maintenance_window_ids.*",
+						"uptimerobot_maintenance_window.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_maintenance_window" "test" {
+  friendly_name = "test"
+  type = "daily"
+  start_time = "02:00"
+  duration = 30
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("uptimerobot_monitor.test", "maintenance_window_ids"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertContactMonitorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "test"
+  type = "e-mail"
+  value = "test@example.com"
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+  alert_contact {
+    id = uptimerobot_alert_contact.test.id
+    threshold = 5
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "alert_contact.#", "1"),
+					resource.TestCheckResourceAttrPair("uptimerobot_monitor.test", "alert_contact.0.id",
+						"uptimerobot_alert_contact.test", "id"),
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "alert_contact.0.threshold", "5"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_alert_contact" "test" {
+  friendly_name = "test"
+  type = "e-mail"
+  value = "test@example.com"
+}
+
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor.test", "alert_contact.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -96,7 +96,7 @@ func (d *monitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 func (d *monitorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state monitorsDataSourceModel
 
-	monitors, err := d.client.GetMonitors()
+	monitors, err := d.client.GetMonitors(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read UptimeRobot monitors", err.Error())
 		return
@@ -106,7 +106,7 @@ func (d *monitorsDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 	for _, monitor := range monitors {
 		monitorID := strconv.Itoa(int(monitor.ID))
 		var monitorType string
-		monitorType, err = uptimerobot.MonitorTypeToStr(monitor.ID)
+		monitorType, err = uptimerobot.MonitorTypeToStr(monitor.Type)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to read UptimeRobot monitor, error determining monitor type",
 				err.Error())
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -17,8 +20,13 @@ import (
 )
 
 const (
-	apiKeyAttributeName = "api_key"
-	apiKeyEnv           = "UPTIMEROBOT_API_KEY"
+	apiKeyAttributeName            = "api_key"
+	apiKeyEnv                      = "UPTIMEROBOT_API_KEY"
+	baseURLAttributeName           = "base_url"
+	baseURLEnv                     = "UPTIMEROBOT_BASE_URL"
+	maxRetriesAttributeName        = "max_retries"
+	retryMaxWaitAttributeName      = "retry_max_wait"
+	requestsPerMinuteAttributeName = "requests_per_minute"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -39,7 +47,11 @@ type uptimerobotProvider struct {
 }
 
 type uptimerobotProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey            types.String `tfsdk:"api_key"`
+	BaseURL           types.String `tfsdk:"base_url"`
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64  `tfsdk:"retry_max_wait"`
+	RequestsPerMinute types.Int64  `tfsdk:"requests_per_minute"`
 }
 
 func (p uptimerobotProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,6 +68,30 @@ func (p uptimerobotProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			baseURLAttributeName: schema.StringAttribute{
+				Description: fmt.Sprintf("Base URL of the UptimeRobot API, can also be set via the %s "+
+					"environment variable. Defaults to https://api.uptimerobot.com/v2", baseURLEnv),
+				Optional: true,
+			},
+			maxRetriesAttributeName: schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of retries for rate limited or failed API requests, "+
+					"defaults to %d", uptimerobot.DefaultMaxRetries),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			retryMaxWaitAttributeName: schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time to wait between retries of an API request (seconds), "+
+					"defaults to %d", int64(uptimerobot.DefaultRetryMaxWait.Seconds())),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			requestsPerMinuteAttributeName: schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of API requests per minute, shared by all resources and "+
+					"data sources. Defaults to %d and is raised to the account plan's limit as reported by the API",
+					uptimerobot.DefaultRequestsPerMinute),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 		},
 	}
 }
@@ -96,21 +132,57 @@ func (p uptimerobotProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(baseURLAttributeName),
+			"Unknown UptimeRobot base URL",
+			fmt.Sprintf("The provider cannot create the UptimeRobot API client as there is an unknown "+
+				"configuration value for the UptimeRobot base URL. Either target apply the source of the value first, "+
+				"set the value statically in the configuration, or use the %s environment variable.", baseURLEnv),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	opts := []uptimerobot.Option{
+		uptimerobot.WithUserAgent(fmt.Sprintf("terraform-provider-uptimerobot/%s", p.version)),
+	}
+
+	baseURL := os.Getenv(baseURLEnv)
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+	if baseURL != "" {
+		ctx = tflog.SetField(ctx, baseURLAttributeName, baseURL)
+		opts = append(opts, uptimerobot.WithBaseURL(baseURL))
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		opts = append(opts, uptimerobot.WithMaxRetries(int(config.MaxRetries.ValueInt64())))
+	}
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retryMaxWait := time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+		opts = append(opts, uptimerobot.WithRetryMaxWait(retryMaxWait))
+	}
+
+	if !config.RequestsPerMinute.IsNull() && !config.RequestsPerMinute.IsUnknown() {
+		opts = append(opts, uptimerobot.WithRequestsPerMinute(int(config.RequestsPerMinute.ValueInt64())))
+	}
+
 	ctx = tflog.SetField(ctx, apiKeyAttributeName, apiKey)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, apiKeyAttributeName)
 
 	tflog.Debug(ctx, "Creating UptimeRobot client")
 
-	c, err := uptimerobot.New(apiKey)
+	c, err := uptimerobot.New(apiKey, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Create UptimeRobot API Client",
 			"An unexpected error occurred when creating the UptimeRobot API client. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
 				"UptimeRobot Client Error: "+err.Error())
+		return
 	}
 
 	resp.DataSourceData = c
@@ -124,13 +196,20 @@ func (p uptimerobotProvider) DataSources(_ context.Context) []func() datasource.
 		NewAccountDetailsDataSource,
 		NewAlertContactDataSource,
 		NewAlertContactsDataSource,
+		NewMaintenanceWindowDataSource,
+		NewMaintenanceWindowsDataSource,
 		NewMonitorsDataSource,
+		NewStatusPageDataSource,
+		NewStatusPagesDataSource,
 	}
 }
 
 func (p uptimerobotProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAlertContactResource,
+		NewMaintenanceWindowResource,
+		NewMonitorAlertContactResource,
 		NewMonitorResource,
+		NewStatusPageResource,
 	}
 }
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ datasource.DataSource              = &statusPageDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusPageDataSource{}
+)
+
+type statusPageDataSource struct {
+	client *uptimerobot.Client
+}
+
+type statusPageDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Monitors     types.Set    `tfsdk:"monitors"`
+	CustomDomain types.String `tfsdk:"custom_domain"`
+	Sort         types.String `tfsdk:"sort"`
+	HideURLLinks types.Bool   `tfsdk:"hide_url_links"`
+	StandardURL  types.String `tfsdk:"standard_url"`
+	CustomURL    types.String `tfsdk:"custom_url"`
+}
+
+func (d *statusPageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *statusPageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (d *statusPageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches status page for the given friendly name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the status page.",
+				Computed:    true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Description: "Friendly name of the status page.",
+				Required:    true,
+			},
+			"monitors": schema.SetAttribute{
+				Description: "Identifiers of the monitors shown on the status page, \"all\" if all monitors are shown.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"custom_domain": schema.StringAttribute{
+				Description: "Custom domain the status page is served on.",
+				Computed:    true,
+			},
+			"sort": schema.StringAttribute{
+				Description: "Order of the monitors on the status page.",
+				Computed:    true,
+			},
+			"hide_url_links": schema.BoolAttribute{
+				Description: "Whether the URLs of the monitors are hidden on the status page.",
+				Computed:    true,
+			},
+			"standard_url": schema.StringAttribute{
+				Description: "URL of the status page on the UptimeRobot domain.",
+				Computed:    true,
+			},
+			"custom_url": schema.StringAttribute{
+				Description: "URL of the status page on the custom domain.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapStatusPageToState(page uptimerobot.StatusPage, state *statusPageDataSourceModel) error {
+	sort, err := uptimerobot.StatusPageSortToString(page.Sort)
+	if err != nil {
+		return err
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(page.ID, 10))
+	state.FriendlyName = types.StringValue(page.FriendlyName)
+	state.Monitors = statusPageMonitorsValue(page.MonitorIDs)
+	state.CustomDomain = optionalStringValue(page.CustomDomain)
+	state.Sort = types.StringValue(sort)
+	state.HideURLLinks = types.BoolValue(page.HideURLLinks)
+	state.StandardURL = types.StringValue(page.StandardURL)
+	state.CustomURL = optionalStringValue(page.CustomURL)
+
+	return nil
+}
+
+func (d *statusPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state statusPageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	friendlyName := state.FriendlyName.ValueString()
+
+	statusPages, err := d.client.GetStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read UptimeRobot status pages", err.Error())
+		return
+	}
+
+	for _, page := range statusPages {
+		if page.FriendlyName != friendlyName {
+			continue
+		}
+
+		err = mapStatusPageToState(page, &state)
+		if err == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		} else {
+			resp.Diagnostics.AddError("Error mapping status page to state", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.AddError("No status page found",
+		fmt.Sprintf("Unable to locate status page with friendly name %s", friendlyName))
+}
+
+func NewStatusPageDataSource() datasource.DataSource {
+	return &statusPageDataSource{}
+}
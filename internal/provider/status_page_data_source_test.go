@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPageDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_status_page" "test" {
+  friendly_name = "test"
+  monitors = ["all"]
+  sort = "z-a"
+}
+
+data "uptimerobot_status_page" "test" {
+  friendly_name = uptimerobot_status_page.test.friendly_name
+}
+
+data "uptimerobot_status_pages" "test" {
+  depends_on = [uptimerobot_status_page.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.uptimerobot_status_page.test", "id",
+						"uptimerobot_status_page.test", "id"),
+					resource.TestCheckResourceAttr("data.uptimerobot_status_page.test", "sort", "z-a"),
+					resource.TestCheckResourceAttr("data.uptimerobot_status_page.test", "monitors.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.uptimerobot_status_page.test", "monitors.*", "all"),
+					resource.TestCheckResourceAttrSet("data.uptimerobot_status_page.test", "standard_url"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.uptimerobot_status_pages.test", "status_pages.*",
+						map[string]string{"friendly_name": "test", "sort": "z-a"}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ resource.Resource                   = &statusPageResource{}
+	_ resource.ResourceWithConfigure      = &statusPageResource{}
+	_ resource.ResourceWithImportState    = &statusPageResource{}
+	_ resource.ResourceWithValidateConfig = &statusPageResource{}
+)
+
+// allMonitors selects all monitors of the account for a status page.
+const allMonitors = "all"
+
+type statusPageResourceModel struct {
+	CustomDomain types.String `tfsdk:"custom_domain"`
+	CustomURL    types.String `tfsdk:"custom_url"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	HideURLLinks types.Bool   `tfsdk:"hide_url_links"`
+	ID           types.String `tfsdk:"id"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+	Monitors     types.Set    `tfsdk:"monitors"`
+	Password     types.String `tfsdk:"password"`
+	Sort         types.String `tfsdk:"sort"`
+	StandardURL  types.String `tfsdk:"standard_url"`
+}
+
+type statusPageResource struct {
+	client *uptimerobot.Client
+}
+
+func NewStatusPageResource() resource.Resource {
+	return &statusPageResource{}
+}
+
+func (r *statusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *statusPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *statusPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (r *statusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var validSorts []string
+	for _, s := range uptimerobot.StatusPageSorts {
+		validSorts = append(validSorts, s)
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a public status page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the status page.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the status page.",
+				Computed:    true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Description: "Friendly name of the status page",
+				Required:    true,
+			},
+			"monitors": schema.SetAttribute{
+				Description: "IDs of the monitors shown on the status page, or \"all\" to show all monitors",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(all|[0-9]+)$`), "must be a monitor ID or \"all\"")),
+				},
+			},
+			"custom_domain": schema.StringAttribute{
+				Description: "Custom domain the status page is served at",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password protecting the status page",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"sort": schema.StringAttribute{
+				Description: "Order of the monitors on the status page, removing it keeps the current order",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(validSorts...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hide_url_links": schema.BoolAttribute{
+				Description: "Whether the links to the monitored URLs are hidden",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"standard_url": schema.StringAttribute{
+				Description: "URL the status page is served at by UptimeRobot",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_url": schema.StringAttribute{
+				Description: "URL the status page is served at on the custom domain",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *statusPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config statusPageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitors := stringSetFromValue(config.Monitors)
+	for _, monitor := range monitors {
+		if monitor == allMonitors && len(monitors) > 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("monitors"), "Invalid status page attribute",
+				"Attribute monitors cannot list monitor IDs in addition to \"all\".")
+		}
+	}
+}
+
+func statusPageFromPlan(plan statusPageResourceModel) (uptimerobot.StatusPage, error) {
+	page := uptimerobot.StatusPage{
+		FriendlyName: plan.FriendlyName.ValueString(),
+		CustomDomain: plan.CustomDomain.ValueString(),
+		Password:     plan.Password.ValueString(),
+		HideURLLinks: plan.HideURLLinks.ValueBool(),
+	}
+
+	for _, monitor := range stringSetFromValue(plan.Monitors) {
+		if monitor == allMonitors {
+			continue
+		}
+
+		id, err := strconv.ParseInt(monitor, 10, 64)
+		if err != nil {
+			return page, fmt.Errorf("invalid monitor ID %q: %w", monitor, err)
+		}
+		page.MonitorIDs = append(page.MonitorIDs, id)
+	}
+
+	if !plan.Sort.IsNull() && !plan.Sort.IsUnknown() {
+		sort, err := uptimerobot.StatusPageSortToDesignator(plan.Sort.ValueString())
+		if err != nil {
+			return page, err
+		}
+		page.Sort = sort
+	}
+
+	return page, nil
+}
+
+func optionalStringValue(str string) types.String {
+	if str == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(str)
+}
+
+// statusPageMonitorsValue converts the monitor IDs of a status page to a set, which contains only "all" if the
+// status page shows all monitors of the account.
+func statusPageMonitorsValue(ids []int64) types.Set {
+	monitors := []string{allMonitors}
+	if len(ids) > 0 {
+		monitors = nil
+		for _, id := range ids {
+			monitors = append(monitors, strconv.FormatInt(id, 10))
+		}
+	}
+
+	return stringSetValue(monitors)
+}
+
+func updateFromStatusPage(model *statusPageResourceModel, page uptimerobot.StatusPage) error {
+	sort, err := uptimerobot.StatusPageSortToString(page.Sort)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringValue(strconv.FormatInt(page.ID, 10))
+	model.FriendlyName = types.StringValue(page.FriendlyName)
+	model.Monitors = statusPageMonitorsValue(page.MonitorIDs)
+	model.CustomDomain = optionalStringValue(page.CustomDomain)
+	model.Sort = types.StringValue(sort)
+	model.HideURLLinks = types.BoolValue(page.HideURLLinks)
+	model.StandardURL = types.StringValue(page.StandardURL)
+	model.CustomURL = optionalStringValue(page.CustomURL)
+
+	// The API does not return the password, so the known value is kept.
+	if !uptimerobot.IsMaskedSecret(page.Password) {
+		model.Password = types.StringValue(page.Password)
+	}
+
+	return nil
+}
+
+// setComputedFromStatusPage sets the values decided by the API for attributes left unknown in the plan.
+func setComputedFromStatusPage(plan *statusPageResourceModel, page uptimerobot.StatusPage) error {
+	plan.ID = types.StringValue(strconv.FormatInt(page.ID, 10))
+	plan.StandardURL = types.StringValue(page.StandardURL)
+	plan.CustomURL = optionalStringValue(page.CustomURL)
+
+	if plan.Sort.IsUnknown() {
+		sort, err := uptimerobot.StatusPageSortToString(page.Sort)
+		if err != nil {
+			return err
+		}
+		plan.Sort = types.StringValue(sort)
+	}
+
+	return nil
+}
+
+func (r *statusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := statusPageFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating status page",
+			"Could not create status page from plan: "+err.Error())
+		return
+	}
+
+	created, err := r.client.CreateStatusPage(ctx, page)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating status page",
+			"Could not create status page, unexpected error: "+err.Error())
+		return
+	}
+
+	// Record the status page before reading it back, so that it is tracked as tainted rather than left unmanaged if
+	// the read fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(created.ID, 10))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err = r.client.GetStatusPage(ctx, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating status page",
+			"Could not read status page after creation, unexpected error: "+err.Error())
+		return
+	}
+
+	err = setComputedFromStatusPage(&plan, page)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating status page",
+			"Could not map created status page to state: "+err.Error())
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *statusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state statusPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot status page",
+			fmt.Sprintf("Could not determine ID of the status page %s: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	page, err := r.client.GetStatusPage(ctx, id)
+	if uptimerobot.IsNotFound(err) {
+		tflog.Warn(ctx, "UptimeRobot status page not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot status page",
+			fmt.Sprintf("Could not read UptimeRobot status page with ID %d: %v", id, err))
+		return
+	}
+
+	err = updateFromStatusPage(&state, page)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading UptimeRobot status page",
+			fmt.Sprintf("Could not map UptimeRobot status page with ID %d to state: %v", id, err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan statusPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := statusPageFromPlan(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating status page",
+			fmt.Sprintf("Could not update status page %v", err))
+		return
+	}
+
+	page.ID, err = strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating status page",
+			fmt.Sprintf("Could not determine status page ID %v", err))
+		return
+	}
+
+	page, err = r.client.UpdateStatusPage(ctx, page)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating status page",
+			fmt.Sprintf("Could not update status page %v", err))
+		return
+	}
+
+	err = setComputedFromStatusPage(&plan, page)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating status page",
+			fmt.Sprintf("Could not map updated status page to state: %v", err))
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *statusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state statusPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting status page",
+			fmt.Sprintf("Could not determine status page ID: %v", err))
+		return
+	}
+
+	err = r.client.DeleteStatusPage(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting status page",
+			fmt.Sprintf("Could not delete status page with ID %d: %v", id, err))
+		return
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPageResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+
+resource "uptimerobot_status_page" "test" {
+  friendly_name = "test"
+  monitors = [uptimerobot_monitor.test.id]
+  password = "secret"
+  sort = "down-up-paused"
+  hide_url_links = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "friendly_name", "test"),
+					resource.TestCheckTypeSetElemAttrPair("uptimerobot_status_page.test", "monitors.*",
+						"uptimerobot_monitor.test", "id"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "sort", "down-up-paused"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "hide_url_links", "true"),
+					resource.TestCheckResourceAttrSet("uptimerobot_status_page.test", "standard_url"),
+				),
+			},
+			{
+				ResourceName:            "uptimerobot_status_page.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "password"},
+			},
+			{
+				Config: providerConfig + `
+resource "uptimerobot_monitor" "test" {
+  friendly_name = "test"
+  url = "http://example.com"
+  type = "http"
+}
+
+resource "uptimerobot_status_page" "test" {
+  friendly_name = "test1"
+  monitors = ["all"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "friendly_name", "test1"),
+					resource.TestCheckTypeSetElemAttr("uptimerobot_status_page.test", "monitors.*", "all"),
+					resource.TestCheckResourceAttr("uptimerobot_status_page.test", "hide_url_links", "false"),
+				),
+			},
+		},
+	})
+}
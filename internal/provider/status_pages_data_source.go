@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	uptimerobot "terraform-provider-uptimerobot/api"
+)
+
+var (
+	_ datasource.DataSource              = &statusPagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusPagesDataSource{}
+)
+
+type statusPagesDataSource struct {
+	client *uptimerobot.Client
+}
+
+type statusPagesDataSourceModel struct {
+	StatusPages []statusPageDataSourceModel `tfsdk:"status_pages"`
+}
+
+func (d *statusPagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*uptimerobot.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *uptimerobot.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *statusPagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages"
+}
+
+func (d *statusPagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches status pages defined for the account",
+		Attributes: map[string]schema.Attribute{
+			"status_pages": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the status page.",
+							Computed:    true,
+						},
+						"friendly_name": schema.StringAttribute{
+							Description: "Friendly name of the status page.",
+							Computed:    true,
+						},
+						"monitors": schema.SetAttribute{
+							Description: "Identifiers of the monitors shown on the status page, \"all\" if all monitors are shown.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"custom_domain": schema.StringAttribute{
+							Description: "Custom domain the status page is served on.",
+							Computed:    true,
+						},
+						"sort": schema.StringAttribute{
+							Description: "Order of the monitors on the status page.",
+							Computed:    true,
+						},
+						"hide_url_links": schema.BoolAttribute{
+							Description: "Whether the URLs of the monitors are hidden on the status page.",
+							Computed:    true,
+						},
+						"standard_url": schema.StringAttribute{
+							Description: "URL of the status page on the UptimeRobot domain.",
+							Computed:    true,
+						},
+						"custom_url": schema.StringAttribute{
+							Description: "URL of the status page on the custom domain.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}}
+}
+
+func (d *statusPagesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state statusPagesDataSourceModel
+
+	statusPages, err := d.client.GetStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read UptimeRobot status pages", err.Error())
+		return
+	}
+
+	for _, page := range statusPages {
+		var data statusPageDataSourceModel
+		err = mapStatusPageToState(page, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Error mapping status page to state", err.Error())
+		}
+		state.StatusPages = append(state.StatusPages, data)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func NewStatusPagesDataSource() datasource.DataSource {
+	return &statusPagesDataSource{}
+}